@@ -44,6 +44,16 @@ type ABCIApplicationInterface struct {
 func NewABCIApplicationInterface() *ABCIApplicationInterface {
 	logger := logrus.WithFields(logrus.Fields{"module": "abci-app"})
 
+	db := NewDB()
+
+	return &ABCIApplicationInterface{
+		appV1: appV1.NewABCIApplication(logger, db),
+		// appV2: appV2.NewABCIApplication(logger, db),
+	}
+}
+
+// NewDB opens app state DB configured by ABCI_DB_TYPE and ABCI_DB_DIR_PATH env
+func NewDB() dbm.DB {
 	var dbType = getEnv("ABCI_DB_TYPE", "goleveldb")
 	var dbDir = getEnv("ABCI_DB_DIR_PATH", "./DID")
 
@@ -51,12 +61,7 @@ func NewABCIApplicationInterface() *ABCIApplicationInterface {
 		panic(fmt.Errorf("Could not create DB directory: %v", err.Error()))
 	}
 	name := "didDB"
-	db := dbm.NewDB(name, dbm.DBBackendType(dbType), dbDir)
-
-	return &ABCIApplicationInterface{
-		appV1: appV1.NewABCIApplication(logger, db),
-		// appV2: appV2.NewABCIApplication(logger, db),
-	}
+	return dbm.NewDB(name, dbm.DBBackendType(dbType), dbDir)
 }
 
 func (app *ABCIApplicationInterface) Info(req types.RequestInfo) types.ResponseInfo {
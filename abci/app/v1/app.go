@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,6 +39,7 @@ import (
 	"github.com/ndidplatform/smart-contract/v4/abci/code"
 	"github.com/ndidplatform/smart-contract/v4/abci/utils"
 	"github.com/ndidplatform/smart-contract/v4/abci/version"
+	protoProof "github.com/ndidplatform/smart-contract/v4/protos/proof"
 	protoTm "github.com/ndidplatform/smart-contract/v4/protos/tendermint"
 )
 
@@ -45,6 +47,7 @@ type ABCIApplication struct {
 	types.BaseApplication
 	AppProtocolVersion  uint64
 	CurrentChain        string
+	CurrentBlockTime    time.Time
 	Version             string
 	checkTxNonceState   *utils.StringByteArrayMap
 	deliverTxNonceState map[string][]byte
@@ -52,6 +55,10 @@ type ABCIApplication struct {
 	state               AppState
 	valUpdates          map[string]types.ValidatorUpdate
 	verifiedSignatures  *utils.StringMap
+	// events of token balance status of current Tx or BeginBlock
+	tokenEvents []types.Event
+	// index of next token ledger entry in current block
+	tokenLedgerIndex int64
 }
 
 func NewABCIApplication(logger *logrus.Entry, db dbm.DB) *ABCIApplication {
@@ -63,6 +70,14 @@ func NewABCIApplication(logger *logrus.Entry, db dbm.DB) *ABCIApplication {
 	}()
 
 	appState := NewAppState(db)
+	stateTreeHeight, err := strconv.ParseInt(getEnv("ABCI_STATE_TREE_HEIGHT", "0"), 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	err = appState.SetStateTreeHeight(stateTreeHeight)
+	if err != nil {
+		panic(err)
+	}
 
 	ABCIVersion := version.Version
 	ABCIProtocolVersion := version.AppProtocolVersion
@@ -104,9 +119,27 @@ func (app *ABCIApplication) BeginBlock(req types.RequestBeginBlock) types.Respon
 	app.logger.Infof("BeginBlock: %d, Chain ID: %s", req.Header.Height, req.Header.ChainID)
 	app.state.CurrentBlockHeight = req.Header.Height
 	app.CurrentChain = req.Header.ChainID
+	app.CurrentBlockTime = req.Header.Time
 	// reset valset changes
 	app.valUpdates = make(map[string]types.ValidatorUpdate, 0)
-	return types.ResponseBeginBlock{}
+	app.tokenLedgerIndex = 0
+	// add requests created before request timeout index to the index
+	app.buildRequestTimeoutIndex()
+	// move nonces of Txs delivered before upgrade under nonce key prefix
+	app.migrateLegacyNonces()
+	// time out requests which deadline has passed
+	events := app.timeOutExpiredRequests()
+	// revert pending identity registrations which are not cleared in time
+	events = append(events, app.timeOutPendingRegisterIdentities()...)
+	// expire NDID proposals which are not approved in time
+	events = append(events, app.expireNDIDProposals()...)
+	// switch public key of nodes which rotated key becomes active
+	events = append(events, app.activateNodeKeys()...)
+	// token balance status events of settlement of timed out requests
+	events = append(events, app.takeTokenEvents()...)
+	// forget nonces of Txs which can no longer be included
+	app.expireNonces()
+	return types.ResponseBeginBlock{Events: events}
 }
 
 // Update the validator set
@@ -135,10 +168,12 @@ func (app *ABCIApplication) DeliverTx(req types.RequestDeliverTx) (res types.Res
 	}
 
 	method := txObj.Method
-	param := txObj.Params
 	nonce := txObj.Nonce
 	signature := txObj.Signature
 	nodeID := txObj.NodeId
+	signatureScheme := txObj.SignatureScheme
+	validUntilBlock := txObj.ValidUntilBlock
+	keyID := txObj.KeyId
 
 	go recordDeliverTxMetrics(method)
 
@@ -162,39 +197,54 @@ func (app *ABCIApplication) DeliverTx(req types.RequestDeliverTx) (res types.Res
 		return app.ReturnDeliverTxLog(code.MethodCanNotBeEmpty, "method can not be empty", "")
 	}
 
+	param, signedParam, retCode, retLog := getTxParams(&txObj)
+	if retCode != code.OK {
+		go recordDeliverTxFailMetrics(method)
+		return app.ReturnDeliverTxLog(retCode, retLog, "")
+	}
+
+	// Check Tx is not expired
+	retCode, retLog = app.checkTxValidUntilBlock(validUntilBlock, false)
+	if retCode != code.OK {
+		go recordDeliverTxFailMetrics(method)
+		return app.ReturnDeliverTxLog(retCode, retLog, "")
+	}
+
 	// Check signature
-	publicKey, retCode, retLog := app.getNodePublicKeyForSignatureVerification(method, param, nodeID, false)
+	retCode, retLog = app.checkSignatureScheme(signatureScheme, false)
+	if retCode != code.OK {
+		go recordDeliverTxFailMetrics(method)
+		return app.ReturnDeliverTxLog(retCode, retLog, "")
+	}
+	publicKey, keyAlgorithm, retCode, retLog := app.getNodePublicKeyForSignatureVerification(method, param, nodeID, keyID, false)
 	if retCode != code.OK {
 		go recordDeliverTxFailMetrics(method)
 		return app.ReturnDeliverTxLog(retCode, retLog, "")
 	}
 
+	keys := app.getTxSignatureKeys(method, nodeID, keyID, publicKey, keyAlgorithm, false)
 	verifiedSignatureKey := string(signature) + "|" + nodeID
 	verifiedSigNodePubKey, verifiedSigResultExist := app.verifiedSignatures.Load(verifiedSignatureKey)
 
 	if verifiedSigResultExist {
 		app.logger.Debugf("Found cached verified Tx signature result")
 		app.verifiedSignatures.Delete(verifiedSignatureKey)
-		if verifiedSigNodePubKey != publicKey {
+		if !isNodePublicKeyInKeys(verifiedSigNodePubKey, keys) {
 			app.logger.Debugf("Node key updated, cached verified Tx signature result is no longer valid")
 			go recordDeliverTxFailMetrics(method)
-			return app.ReturnDeliverTxLog(code.VerifySignatureError, err.Error(), "")
+			return app.ReturnDeliverTxLog(code.VerifySignatureError, "Invalid Tx signature", "")
 		}
 	} else {
 		app.logger.Debugf("Cached verified Tx signature result could not be found")
 		app.logger.Debugf("Verifying Tx signature")
-		verifyResult, err := verifySignature(param, nonce, signature, publicKey, method)
+		_, err := verifySignatureWithNodeKeys(signedParam, nonce, signature, keys, method, signatureScheme, validUntilBlock)
 		if err != nil {
 			go recordDeliverTxFailMetrics(method)
 			return app.ReturnDeliverTxLog(code.VerifySignatureError, err.Error(), "")
 		}
-		if verifyResult == false {
-			go recordDeliverTxFailMetrics(method)
-			return app.ReturnDeliverTxLog(code.VerifySignatureError, "Invalid Tx signature", "")
-		}
 	}
 
-	result := app.DeliverTxRouter(method, param, nonce, signature, nodeID)
+	result := app.DeliverTxRouter(method, param, nonce, signature, nodeID, validUntilBlock)
 	app.logger.Infof(
 		`DeliverTx response: {"code":%d,"log":"%s","attributes":[{"key":"%s","value":"%s"}]}`,
 		result.Code,
@@ -223,10 +273,12 @@ func (app *ABCIApplication) CheckTx(req types.RequestCheckTx) (res types.Respons
 	}
 
 	method := txObj.Method
-	param := txObj.Params
 	nonce := txObj.Nonce
 	signature := txObj.Signature
 	nodeID := txObj.NodeId
+	signatureScheme := txObj.SignatureScheme
+	validUntilBlock := txObj.ValidUntilBlock
+	keyID := txObj.KeyId
 
 	go recordCheckTxMetrics(method)
 
@@ -259,7 +311,7 @@ func (app *ABCIApplication) CheckTx(req types.RequestCheckTx) (res types.Respons
 
 	app.logger.Infof("CheckTx: %s, NodeID: %s", method, nodeID)
 
-	if method == "" || param == "" || nonce == nil || signature == nil || nodeID == "" {
+	if method == "" || (txObj.Params == "" && len(txObj.TypedParams) == 0) || nonce == nil || signature == nil || nodeID == "" {
 		res.Code = code.InvalidTransactionFormat
 		res.Log = "Invalid transaction format"
 		go recordCheckTxFailMetrics(method)
@@ -274,23 +326,38 @@ func (app *ABCIApplication) CheckTx(req types.RequestCheckTx) (res types.Respons
 		return res
 	}
 
+	param, signedParam, retCode, retLog := getTxParams(&txObj)
+	if retCode != code.OK {
+		go recordCheckTxFailMetrics(method)
+		return ReturnCheckTx(retCode, retLog)
+	}
+
+	// Check Tx is not expired
+	retCode, retLog = app.checkTxValidUntilBlock(validUntilBlock, true)
+	if retCode != code.OK {
+		go recordCheckTxFailMetrics(method)
+		return ReturnCheckTx(retCode, retLog)
+	}
+
 	// Check signature
-	publicKey, retCode, retLog := app.getNodePublicKeyForSignatureVerification(method, param, nodeID, true)
+	retCode, retLog = app.checkSignatureScheme(signatureScheme, true)
+	if retCode != code.OK {
+		go recordCheckTxFailMetrics(method)
+		return ReturnCheckTx(retCode, retLog)
+	}
+	publicKey, keyAlgorithm, retCode, retLog := app.getNodePublicKeyForSignatureVerification(method, param, nodeID, keyID, true)
 	if retCode != code.OK {
 		return ReturnCheckTx(retCode, retLog)
 	}
 
-	verifyResult, err := verifySignature(param, nonce, signature, publicKey, method)
+	keys := app.getTxSignatureKeys(method, nodeID, keyID, publicKey, keyAlgorithm, true)
+	verifiedPublicKey, err := verifySignatureWithNodeKeys(signedParam, nonce, signature, keys, method, signatureScheme, validUntilBlock)
 	if err != nil {
 		go recordCheckTxFailMetrics(method)
 		return ReturnCheckTx(code.VerifySignatureError, err.Error())
 	}
-	if verifyResult == false {
-		go recordCheckTxFailMetrics(method)
-		return ReturnCheckTx(code.VerifySignatureError, "Invalid Tx signature")
-	}
 	verifiedSignatureKey := string(signature) + "|" + nodeID
-	app.verifiedSignatures.Store(verifiedSignatureKey, publicKey)
+	app.verifiedSignatures.Store(verifiedSignatureKey, verifiedPublicKey)
 
 	result := app.CheckTxRouter(method, param, nonce, signature, nodeID, true)
 	if result.Code != code.OK {
@@ -300,15 +367,11 @@ func (app *ABCIApplication) CheckTx(req types.RequestCheckTx) (res types.Respons
 	return result
 }
 
-func hash(data []byte) []byte {
-	sum := sha256.Sum256(data)
-	return sum[:]
-}
-
 func (app *ABCIApplication) Commit() types.ResponseCommit {
 	startTime := time.Now()
 	app.logger.Infof("Commit")
 
+	app.pruneVersions()
 	app.state.Save()
 	app.state.Height = app.state.Height + 1
 	dbSaveDuration := time.Since(startTime)
@@ -320,17 +383,19 @@ func (app *ABCIApplication) Commit() types.ResponseCommit {
 	app.deliverTxNonceState = make(map[string][]byte)
 
 	appHashStartTime := time.Now()
-	// Calculate app hash
-	if len(app.state.HashData) > 0 {
+	// Calculate app hash (root hash of state tree from state tree height,
+	// hash chain of changed data before)
+	if app.state.IsStateTreeHeight(app.state.Height) {
+		app.state.AppHash = app.state.Hash()
+	} else if len(app.state.HashData) > 0 {
 		app.state.HashData = append(app.state.AppHash, app.state.HashData...)
-		app.state.AppHash = hash(app.state.HashData)
+		sum := sha256.Sum256(app.state.HashData)
+		app.state.AppHash = sum[:]
 	}
 	appHash := app.state.AppHash
 	appHashDuration := time.Since(appHashStartTime)
 	go recordAppHashDurationMetrics(appHashDuration)
 
-	app.state.HashData = make([]byte, 0)
-
 	// Save state
 	app.state.SaveMetadata()
 
@@ -348,6 +413,10 @@ func (app *ABCIApplication) Query(reqQuery types.RequestQuery) (res types.Respon
 		}
 	}()
 
+	if reqQuery.Path == stateKeyQueryPath {
+		return app.queryStateKey(reqQuery.Data, reqQuery.Prove)
+	}
+
 	var query protoTm.Query
 	err := proto.Unmarshal(reqQuery.Data, &query)
 	if err != nil {
@@ -374,7 +443,57 @@ func (app *ABCIApplication) Query(reqQuery types.RequestQuery) (res types.Respon
 	if method == "" {
 		return app.ReturnQuery(nil, "method can't be empty", app.state.Height)
 	}
-	return app.QueryRouter(method, param, height)
+	typedQuery := len(query.TypedParams) > 0
+	if typedQuery {
+		if param != "" {
+			return app.ReturnQuery(nil, "Params and typed params cannot be both set", app.state.Height)
+		}
+		param, err = decodeTypedQueryParams(method, query.TypedParams)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), app.state.Height)
+		}
+	}
+	if height < 0 || height > app.state.Height {
+		return app.ReturnQuery(nil, fmt.Sprintf("invalid height %d, latest height is %d", height, app.state.Height), app.state.Height)
+	}
+
+	// Versioned data (node, identity, service, namespace, token, proxy) is read at query height
+	app.state.SetCommittedReadHeight(height)
+	defer app.state.SetCommittedReadHeight(0)
+
+	if !reqQuery.Prove {
+		res = app.QueryRouter(method, param, height)
+		if typedQuery {
+			res = app.typedQueryResult(method, res)
+		}
+		res.Height = height
+		return res
+	}
+
+	if !app.state.IsStateTreeHeight(app.state.Height) {
+		return app.ReturnQuery(nil, ErrStateTreeHeightNotReached.Error(), app.state.Height)
+	}
+
+	// Attach raw values and proofs of all state keys read by query.
+	// Versions of keys are kept in the latest state so proofs
+	// are against app hash of the latest height which is returned as
+	// response height. Query height is returned in ProvenQueryResult.
+	app.state.StartRecordCommittedReads()
+	defer app.state.StopRecordCommittedReads()
+	res = app.QueryRouter(method, param, height)
+	if typedQuery {
+		res = app.typedQueryResult(method, res)
+	}
+	var result protoProof.ProvenQueryResult
+	result.Value = res.Value
+	result.Height = height
+	result.State, res.Proof = app.state.ProveKeys(app.state.StopRecordCommittedReads())
+	res.Value, err = utils.ProtoDeterministicMarshal(&result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	res.Height = app.state.Height
+	return res
 }
 
 func getEnv(key, defaultValue string) string {
@@ -24,6 +24,7 @@ package app
 
 import (
 	"encoding/json"
+	"strconv"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/tendermint/tendermint/abci/types"
@@ -160,6 +161,7 @@ func (app *ABCIApplication) signData(param string, nodeID string) types.Response
 
 	signDataKey := dataSignatureKeyPrefix + keySeparator + nodeID + keySeparator + signData.ServiceID + keySeparator + signData.RequestID
 	signDataValue := signData.Signature
+	signDataBlockKey := dataSignatureBlockKeyPrefix + keySeparator + nodeID + keySeparator + signData.ServiceID + keySeparator + signData.RequestID
 
 	// Update answered_as_id_list in request
 	for index, dataRequest := range request.DataRequestList {
@@ -175,6 +177,7 @@ func (app *ABCIApplication) signData(param string, nodeID string) types.Response
 
 	app.state.SetVersioned([]byte(requestKey), []byte(requestJSON))
 	app.state.Set([]byte(signDataKey), []byte(signDataValue))
+	app.state.Set([]byte(signDataBlockKey), []byte(strconv.FormatInt(app.state.CurrentBlockHeight, 10)))
 	return app.ReturnDeliverTxLog(code.OK, "success", signData.RequestID)
 }
 
@@ -203,6 +206,14 @@ func (app *ABCIApplication) registerServiceDestination(param string, nodeID stri
 		return app.ReturnDeliverTxLog(code.ServiceIsNotActive, "Service is not active", "")
 	}
 
+	price, err := parseTokenAmount(funcParam.Price, app.getTokenDecimals(false))
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.InvalidTokenAmount, err.Error(), "")
+	}
+	if price < 0 {
+		return app.ReturnDeliverTxLog(code.PriceMustBeGreaterOrEqualToZero, "Price must be greater than or equal to zero", "")
+	}
+
 	provideServiceKey := providedServicesKeyPrefix + keySeparator + nodeID
 	provideServiceValue, _ := app.state.Get([]byte(provideServiceKey), false)
 	var services data.ServiceList
@@ -273,6 +284,7 @@ func (app *ABCIApplication) registerServiceDestination(param string, nodeID stri
 		newNode.ServiceId = funcParam.ServiceID
 		newNode.SupportedNamespaceList = funcParam.SupportedNamespaceList
 		newNode.Active = true
+		newNode.Price = price
 		nodes.Node = append(nodes.Node, &newNode)
 		value, err := utils.ProtoDeterministicMarshal(&nodes)
 		if err != nil {
@@ -288,6 +300,7 @@ func (app *ABCIApplication) registerServiceDestination(param string, nodeID stri
 		newNode.ServiceId = funcParam.ServiceID
 		newNode.SupportedNamespaceList = funcParam.SupportedNamespaceList
 		newNode.Active = true
+		newNode.Price = price
 		nodes.Node = append(nodes.Node, &newNode)
 		value, err := utils.ProtoDeterministicMarshal(&nodes)
 		if err != nil {
@@ -319,6 +332,17 @@ func (app *ABCIApplication) updateServiceDestination(param string, nodeID string
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
 
+	var price int64
+	if funcParam.Price != nil {
+		price, err = parseTokenAmount(*funcParam.Price, app.getTokenDecimals(false))
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.InvalidTokenAmount, err.Error(), "")
+		}
+		if price < 0 {
+			return app.ReturnDeliverTxLog(code.PriceMustBeGreaterOrEqualToZero, "Price must be greater than or equal to zero", "")
+		}
+	}
+
 	// Update ServiceDestination
 	serviceDestinationKey := serviceDestinationKeyPrefix + keySeparator + funcParam.ServiceID
 	serviceDestinationValue, _ := app.state.Get([]byte(serviceDestinationKey), false)
@@ -345,6 +369,9 @@ func (app *ABCIApplication) updateServiceDestination(param string, nodeID string
 			if len(funcParam.SupportedNamespaceList) > 0 {
 				nodes.Node[index].SupportedNamespaceList = funcParam.SupportedNamespaceList
 			}
+			if funcParam.Price != nil {
+				nodes.Node[index].Price = price
+			}
 			break
 		}
 	}
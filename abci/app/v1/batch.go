@@ -0,0 +1,151 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+)
+
+// Methods which cannot be operations of batch. Signatures of these methods
+// are not verified with node key and batch cannot be nested.
+var isNotBatchableMethod = map[string]bool{
+	"InitNDID":              true,
+	"UpdateNode":            true,
+	"RotateNodeKey":         true,
+	"AddNodeDelegateKey":    true,
+	"RemoveNodeDelegateKey": true,
+	"Batch":                 true,
+}
+
+func parseBatchParam(param string) (*BatchParam, uint32, string) {
+	var funcParam BatchParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return nil, code.UnmarshalError, err.Error()
+	}
+	if len(funcParam.Operations) == 0 {
+		return nil, code.BatchOperationsCannotBeEmpty, "Batch operations cannot be empty"
+	}
+	for index, operation := range funcParam.Operations {
+		if !IsMethod[operation.Method] {
+			return nil, code.UnknownMethod, fmt.Sprintf("Operation %d: Unknown method name", index)
+		}
+		if isNotBatchableMethod[operation.Method] {
+			return nil, code.MethodCannotBeInBatch, fmt.Sprintf("Operation %d: Method cannot be in batch", index)
+		}
+		if operation.Params == "" {
+			return nil, code.InvalidTransactionFormat, fmt.Sprintf("Operation %d: Invalid transaction format", index)
+		}
+	}
+	return &funcParam, code.OK, ""
+}
+
+// checkTxBatch checks every operation of batch. Operations are checked
+// against the same state so an operation that depends on an earlier
+// operation of the batch may only fail in DeliverTx.
+func (app *ABCIApplication) checkTxBatch(param string, nonce []byte, signature []byte, nodeID string, committedState bool) types.ResponseCheckTx {
+	funcParam, retCode, retLog := parseBatchParam(param)
+	if retCode != code.OK {
+		return ReturnCheckTx(retCode, retLog)
+	}
+	for index, operation := range funcParam.Operations {
+		result := app.CheckTxRouter(operation.Method, operation.Params, nonce, signature, nodeID, committedState)
+		if result.Code != code.OK {
+			return ReturnCheckTx(result.Code, fmt.Sprintf("Operation %d: %s", index, result.Log))
+		}
+	}
+	return ReturnCheckTx(code.OK, "")
+}
+
+// deliverTxBatch executes operations of batch in order. If any operation
+// fails, changes of all operations are rolled back. Result of each executed
+// operation is returned as a "did.batch_operation_result" event.
+func (app *ABCIApplication) deliverTxBatch(param string, nonce []byte, signature []byte, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("Batch, Parameter: %s", param)
+	funcParam, retCode, retLog := parseBatchParam(param)
+	if retCode != code.OK {
+		return app.ReturnDeliverTxLog(retCode, retLog, "")
+	}
+
+	checkpoint := app.checkpoint()
+	// Operations which were charged fee (those passed authorization)
+	var chargedOperations []BatchOperation
+
+	operationEvents := make([]types.Event, 0, len(funcParam.Operations))
+	// Other events of operations (e.g. token settlement) are emitted as is
+	// only when the whole batch succeeds
+	var otherEvents []types.Event
+	for index, operation := range funcParam.Operations {
+		result := app.deliverTxOperation(operation.Method, operation.Params, nonce, signature, nodeID)
+		var attributes []cmn.KVPair
+		var attribute cmn.KVPair
+		attribute.Key = []byte("operation_index")
+		attribute.Value = []byte(strconv.Itoa(index))
+		attributes = append(attributes, attribute)
+		attribute.Key = []byte("method")
+		attribute.Value = []byte(operation.Method)
+		attributes = append(attributes, attribute)
+		attribute.Key = []byte("code")
+		attribute.Value = []byte(strconv.FormatUint(uint64(result.Code), 10))
+		attributes = append(attributes, attribute)
+		for _, event := range result.Events {
+			if event.Type == "did.fee" {
+				chargedOperations = append(chargedOperations, operation)
+			}
+			if event.Type != "did.result" {
+				otherEvents = append(otherEvents, event)
+				continue
+			}
+			attributes = append(attributes, event.Attributes...)
+		}
+		operationEvents = append(operationEvents, types.Event{
+			Type:       "did.batch_operation_result",
+			Attributes: attributes,
+		})
+		if result.Code != code.OK {
+			app.logger.Infof("Batch operation %d (%s) failed, rolling back batch", index, operation.Method)
+			app.rollback(checkpoint)
+			response := app.ReturnDeliverTxLog(result.Code, fmt.Sprintf("Operation %d: %s", index, result.Log), "")
+			response.Events = append(response.Events, operationEvents...)
+			// Fees charged by operations are rolled back with the batch.
+			// Each charged operation is charged again as a failed Tx
+			// according to fee policy of its method.
+			for _, chargedOperation := range chargedOperations {
+				app.chargeTxFee(chargedOperation.Method, chargedOperation.Params, nodeID, &response)
+			}
+			response.Events = append(response.Events, app.takeTokenEvents()...)
+			return response
+		}
+	}
+	response := app.ReturnDeliverTxLog(code.OK, "success", "")
+	response.Events = append(response.Events, operationEvents...)
+	response.Events = append(response.Events, otherEvents...)
+	return response
+}
@@ -24,22 +24,19 @@ package app
 
 import (
 	"crypto"
-	"crypto/dsa"
-	"crypto/ecdsa"
 	"crypto/rsa"
-	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/tendermint/tendermint/abci/types"
 
 	"github.com/ndidplatform/smart-contract/v4/abci/code"
 	"github.com/ndidplatform/smart-contract/v4/protos/data"
+	protoTm "github.com/ndidplatform/smart-contract/v4/protos/tendermint"
 )
 
 var IsMethod = map[string]bool{
@@ -66,6 +63,8 @@ var IsMethod = map[string]bool{
 	"RegisterIdentity":                 true,
 	"AddAccessor":                      true,
 	"CreateIdpResponse":                true,
+	"UpdateIdpResponse":                true,
+	"WithdrawIdpResponse":              true,
 	"RegisterAccessor":                 true,
 	"UpdateIdentity":                   true,
 	"SignData":                         true,
@@ -74,6 +73,9 @@ var IsMethod = map[string]bool{
 	"CreateRequest":                    true,
 	"SetMqAddresses":                   true,
 	"UpdateNode":                       true,
+	"RotateNodeKey":                    true,
+	"AddNodeDelegateKey":               true,
+	"RemoveNodeDelegateKey":            true,
 	"CloseRequest":                     true,
 	"TimeOutRequest":                   true,
 	"SetDataReceived":                  true,
@@ -95,6 +97,20 @@ var IsMethod = map[string]bool{
 	"UpdateNamespace":                  true,
 	"SetAllowedMinIalForRegisterIdentityAtFirstIdp": true,
 	"RevokeAndAddAccessor":                          true,
+	"SetVersionPruningPolicy":                       true,
+	"MergeReferenceGroup":                           true,
+	"ActivateIdentity":                              true,
+	"DeactivateIdentity":                            true,
+	"SetMinimumSignatureScheme":                     true,
+	"SetGovernance":                                 true,
+	"CreateNDIDProposal":                            true,
+	"ApproveNDIDProposal":                           true,
+	"Batch":                                         true,
+	"SetIdpResponseFee":                             true,
+	"TransferToken":                                 true,
+	"SetTokenTransferPolicy":                        true,
+	"SetFeePolicy":                                  true,
+	"SetNodeCreditLimit":                            true,
 }
 
 func (app *ABCIApplication) checkTxInitNDID(param string, nodeID string) types.ResponseCheckTx {
@@ -179,6 +195,20 @@ func (app *ABCIApplication) checkIdPorRP(param string, nodeID string) bool {
 	return true
 }
 
+func (app *ABCIApplication) checkNDIDorIdP(param string, nodeID string) bool {
+	nodeDetailKey := nodeIDKeyPrefix + keySeparator + nodeID
+	value, _ := app.state.Get([]byte(nodeDetailKey), true)
+	var node data.NodeDetail
+	err := proto.Unmarshal(value, &node)
+	if err != nil {
+		return false
+	}
+	if node.Role != "NDID" && node.Role != "IdP" {
+		return false
+	}
+	return true
+}
+
 func (app *ABCIApplication) checkIsNDID(param string, nodeID string) types.ResponseCheckTx {
 	ok := app.checkNDID(param, nodeID, true)
 	if ok == false {
@@ -211,6 +241,14 @@ func (app *ABCIApplication) checkIsRPorIdP(param string, nodeID string) types.Re
 	return ReturnCheckTx(code.OK, "")
 }
 
+func (app *ABCIApplication) checkIsNDIDorIdP(param string, nodeID string) types.ResponseCheckTx {
+	ok := app.checkNDIDorIdP(param, nodeID)
+	if ok == false {
+		return ReturnCheckTx(code.NoPermissionForCallNDIDandIdPMethod, "This node does not have permission to call NDID and IdP method")
+	}
+	return ReturnCheckTx(code.OK, "")
+}
+
 func (app *ABCIApplication) checkIsOwnerRequest(param string, nodeID string, committedState bool) types.ResponseCheckTx {
 	var funcParam RequestIDParam
 	err := json.Unmarshal([]byte(param), &funcParam)
@@ -235,29 +273,42 @@ func (app *ABCIApplication) checkIsOwnerRequest(param string, nodeID string, com
 	return ReturnCheckTx(code.OK, "")
 }
 
-func verifySignature(param string, nonce []byte, signature []byte, publicKey string, method string) (result bool, err error) {
-	publicKey = strings.Replace(publicKey, "\t", "", -1)
-	block, _ := pem.Decode([]byte(publicKey))
-	senderPublicKeyInterface, err := x509.ParsePKIXPublicKey(block.Bytes)
-	senderPublicKey := senderPublicKeyInterface.(*rsa.PublicKey)
-	if err != nil {
-		return false, err
+// verifySignature verifies Tx signature over method, params and nonce.
+// Signed data is base64 encoded except for PSS_SHA256 scheme.
+// PSS schemes use RSA PSS padding instead of PKCS#1 v1.5 for RSA key.
+func verifySignature(param string, nonce []byte, signature []byte, publicKey string, keyAlgorithm string, method string, signatureScheme protoTm.SignatureScheme, validUntilBlock int64) (result bool, err error) {
+	message := append([]byte(method), []byte(param)...)
+	message = append(message, []byte(nonce)...)
+	// Signed data of Tx with expiry starts with valid until block so that it
+	// cannot be the same as signed data of Tx without expiry (starts with method)
+	if validUntilBlock != 0 {
+		message = append([]byte(strconv.FormatInt(validUntilBlock, 10)+"|"), message...)
+	}
+	if signatureScheme != protoTm.SignatureScheme_PSS_SHA256 {
+		message = []byte(base64.StdEncoding.EncodeToString(message))
 	}
-	tempPSSmessage := append([]byte(method), []byte(param)...)
-	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
-	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
-	newhash := crypto.SHA256
-	pssh := newhash.New()
-	pssh.Write(PSSmessage)
-	hashed := pssh.Sum(nil)
+	hash := crypto.SHA256.New()
+	hash.Write(message)
+	hashed := hash.Sum(nil)
 
-	err = rsa.VerifyPKCS1v15(senderPublicKey, newhash, hashed, signature)
+	rsaPSS := signatureScheme != protoTm.SignatureScheme_PKCS1V15_SHA256_BASE64
+	err = verifyPublicKeySignature(publicKey, keyAlgorithm, message, hashed, signature, rsaPSS)
 	if err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
+func (app *ABCIApplication) checkSignatureScheme(signatureScheme protoTm.SignatureScheme, committedState bool) (uint32, string) {
+	if _, ok := protoTm.SignatureScheme_name[int32(signatureScheme)]; !ok {
+		return code.InvalidSignatureScheme, "Invalid signature scheme"
+	}
+	if signatureScheme < app.getMinimumSignatureSchemeFromStateDB(committedState) {
+		return code.SignatureSchemeIsLowerThanMinimum, "Signature scheme is lower than minimum signature scheme"
+	}
+	return code.OK, ""
+}
+
 // ReturnCheckTx return types.ResponseDeliverTx
 func ReturnCheckTx(code uint32, log string) types.ResponseCheckTx {
 	return types.ResponseCheckTx{
@@ -266,25 +317,29 @@ func ReturnCheckTx(code uint32, log string) types.ResponseCheckTx {
 	}
 }
 
-func (app *ABCIApplication) getNodePublicKeyForSignatureVerification(method string, param string, nodeID string, committedState bool) (string, uint32, string) {
+func (app *ABCIApplication) getNodePublicKeyForSignatureVerification(method string, param string, nodeID string, keyID string, committedState bool) (string, string, uint32, string) {
 	var publicKey string
+	var keyAlgorithm string
+	if keyID != "" {
+		return app.getDelegatePublicKey(method, param, nodeID, keyID, committedState)
+	}
 	if method == "InitNDID" {
 		publicKey = getPublicKeyInitNDID(param)
 		if publicKey == "" {
-			return publicKey, code.CannotGetPublicKeyFromParam, "Can not get public key from parameter"
+			return publicKey, keyAlgorithm, code.CannotGetPublicKeyFromParam, "Can not get public key from parameter"
 		}
-	} else if method == "UpdateNode" {
-		publicKey = app.getMasterPublicKeyFromNodeID(nodeID, committedState)
+	} else if IsMasterKeyMethod[method] {
+		publicKey, keyAlgorithm = app.getMasterPublicKeyFromNodeID(nodeID, committedState)
 		if publicKey == "" {
-			return publicKey, code.CannotGetMasterPublicKeyFromNodeID, "Can not get master public key from node ID"
+			return publicKey, keyAlgorithm, code.CannotGetMasterPublicKeyFromNodeID, "Can not get master public key from node ID"
 		}
 	} else {
-		publicKey = app.getPublicKeyFromNodeID(nodeID, committedState)
+		publicKey, keyAlgorithm = app.getPublicKeyFromNodeID(nodeID, committedState)
 		if publicKey == "" {
-			return publicKey, code.CannotGetPublicKeyFromNodeID, "Can not get public key from node ID"
+			return publicKey, keyAlgorithm, code.CannotGetPublicKeyFromNodeID, "Can not get public key from node ID"
 		}
 	}
-	return publicKey, code.OK, ""
+	return publicKey, keyAlgorithm, code.OK, ""
 }
 
 func getPublicKeyInitNDID(param string) string {
@@ -296,32 +351,32 @@ func getPublicKeyInitNDID(param string) string {
 	return funcParam.PublicKey
 }
 
-func (app *ABCIApplication) getMasterPublicKeyFromNodeID(nodeID string, committedState bool) string {
+func (app *ABCIApplication) getMasterPublicKeyFromNodeID(nodeID string, committedState bool) (string, string) {
 	key := nodeIDKeyPrefix + keySeparator + nodeID
 	value, _ := app.state.Get([]byte(key), committedState)
 	if value == nil {
-		return ""
+		return "", ""
 	}
 	var nodeDetail data.NodeDetail
 	err := proto.Unmarshal(value, &nodeDetail)
 	if err != nil {
-		return ""
+		return "", ""
 	}
-	return nodeDetail.MasterPublicKey
+	return nodeDetail.MasterPublicKey, nodeDetail.MasterPublicKeyAlgorithm
 }
 
-func (app *ABCIApplication) getPublicKeyFromNodeID(nodeID string, committedState bool) string {
+func (app *ABCIApplication) getPublicKeyFromNodeID(nodeID string, committedState bool) (string, string) {
 	key := nodeIDKeyPrefix + keySeparator + nodeID
 	value, _ := app.state.Get([]byte(key), committedState)
 	if value == nil {
-		return ""
+		return "", ""
 	}
 	var nodeDetail data.NodeDetail
 	err := proto.Unmarshal(value, &nodeDetail)
 	if err != nil {
-		return ""
+		return "", ""
 	}
-	return nodeDetail.PublicKey
+	return nodeDetail.PublicKey, nodeDetail.PublicKeyAlgorithm
 }
 
 func (app *ABCIApplication) getRoleFromNodeID(nodeID string) string {
@@ -343,20 +398,21 @@ func checkPubKey(key string) (returnCode uint32, log string) {
 	if block == nil {
 		return code.InvalidKeyFormat, "Invalid key format. Cannot decode PEM."
 	}
-	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
-	if err != nil {
+	pub, _, err := parsePublicKey(key)
+	switch err {
+	case nil:
+	case errUnsupportedKeyType:
+		return code.UnsupportedKeyType, "Unsupported key type. Only RSA, ECDSA (secp256r1, secp256k1) and Ed25519 are allowed."
+	case errUnknownKeyType:
+		return code.UnknownKeyType, "Unknown key type. Only RSA, ECDSA (secp256r1, secp256k1) and Ed25519 are allowed."
+	default:
 		return code.InvalidKeyFormat, err.Error()
 	}
 
-	switch pubKey := pub.(type) {
-	case *rsa.PublicKey:
+	if pubKey, ok := pub.(*rsa.PublicKey); ok {
 		if pubKey.N.BitLen() < 2048 {
 			return code.RSAKeyLengthTooShort, "RSA key length is too short. Must be at least 2048-bit."
 		}
-	case *dsa.PublicKey, *ecdsa.PublicKey:
-		return code.UnsupportedKeyType, "Unsupported key type. Only RSA is allowed."
-	default:
-		return code.UnknownKeyType, "Unknown key type. Only RSA is allowed."
 	}
 	return code.OK, ""
 }
@@ -388,6 +444,17 @@ func checkNodePubKeys(param string) (returnCode uint32, log string) {
 	return code.OK, ""
 }
 
+func checkRequiredNodePubKey(param string) (returnCode uint32, log string) {
+	var key struct {
+		PublicKey string `json:"public_key"`
+	}
+	err := json.Unmarshal([]byte(param), &key)
+	if err != nil {
+		return code.UnmarshalError, err.Error()
+	}
+	return checkPubKey(key.PublicKey)
+}
+
 func checkAccessorPubKey(param string) (returnCode uint32, log string) {
 	var key struct {
 		AccessorPublicKey string `json:"accessor_public_key"`
@@ -410,7 +477,10 @@ var IsCheckOwnerRequestMethod = map[string]bool{
 }
 
 var IsMasterKeyMethod = map[string]bool{
-	"UpdateNode": true,
+	"UpdateNode":            true,
+	"RotateNodeKey":         true,
+	"AddNodeDelegateKey":    true,
+	"RemoveNodeDelegateKey": true,
 }
 
 func (app *ABCIApplication) checkCanCreateTx(committedState bool) types.ResponseCheckTx {
@@ -454,6 +524,17 @@ func (app *ABCIApplication) checkLastBlock(committedState bool) types.ResponseCh
 // TODO: add parameter "committedState" (bool) since both CheckTx and DeliverTx call this function
 // CheckTx must get committed state while DeliverTx must get uncommitted state
 func (app *ABCIApplication) CheckTxRouter(method string, param string, nonce []byte, signature []byte, nodeID string, committedState bool) types.ResponseCheckTx {
+	return app.checkTxRouter(method, param, nonce, signature, nodeID, committedState, false)
+}
+
+// checkTxRouter checks Tx of method. NDID method is allowed when governance is
+// enabled only if it is approved by governance (executing NDID proposal).
+func (app *ABCIApplication) checkTxRouter(method string, param string, nonce []byte, signature []byte, nodeID string, committedState bool, approvedByGovernance bool) types.ResponseCheckTx {
+
+	// ---- Check each operation of batch ----
+	if method == "Batch" {
+		return app.checkTxBatch(param, nonce, signature, nodeID, committedState)
+	}
 
 	// ---- Check current block <= last block ----
 	if method != "SetLastBlock" {
@@ -476,13 +557,23 @@ func (app *ABCIApplication) CheckTxRouter(method string, param string, nonce []b
 		}
 	}
 
+	// ---- Check NDID method is not called directly when governance is enabled ----
+	if isNDIDMethod[method] && !approvedByGovernance && app.isGovernanceEnabled(committedState) {
+		return ReturnCheckTx(code.NDIDMethodMustBeProposedToGovernance, "NDID method must be proposed and approved by governance keys")
+	}
+
 	// Check pub key
 	if method == "InitNDID" || method == "RegisterNode" || method == "UpdateNode" {
 		checkCode, log := checkNodePubKeys(param)
 		if checkCode != code.OK {
 			return ReturnCheckTx(checkCode, log)
 		}
-	} else if method == "RegisterAccessor" || method == "AddAccessor" {
+	} else if method == "RotateNodeKey" || method == "AddNodeDelegateKey" {
+		checkCode, log := checkRequiredNodePubKey(param)
+		if checkCode != code.OK {
+			return ReturnCheckTx(checkCode, log)
+		}
+	} else if method == "RegisterAccessor" || method == "AddAccessor" || method == "RegisterIdentity" || method == "RevokeAndAddAccessor" {
 		checkCode, log := checkAccessorPubKey(param)
 		if checkCode != code.OK {
 			return ReturnCheckTx(checkCode, log)
@@ -542,8 +633,8 @@ func (app *ABCIApplication) CheckTxRouter(method string, param string, nonce []b
 	// check token for create Tx
 	if result.Code == code.OK {
 		if !app.checkNDID(param, nodeID, committedState) && method != "InitNDID" {
-			needToken := app.getTokenPriceByFunc(method, committedState)
-			nodeToken, err := app.getToken(nodeID, committedState)
+			needToken := app.getTxFee(method, nodeID, true, committedState)
+			nodeToken, err := app.getAvailableToken(nodeID, committedState)
 			if err != nil {
 				result.Code = code.TokenAccountNotFound
 				result.Log = "token account not found"
@@ -589,11 +680,22 @@ func (app *ABCIApplication) callCheckTx(name string, param string, nodeID string
 		"SetLastBlock",
 		"SetAllowedModeList",
 		"UpdateNamespace",
-		"SetAllowedMinIalForRegisterIdentityAtFirstIdp":
+		"SetAllowedMinIalForRegisterIdentityAtFirstIdp",
+		"SetVersionPruningPolicy",
+		"SetMinimumSignatureScheme",
+		"SetIdpResponseFee",
+		"SetTokenTransferPolicy",
+		"SetFeePolicy",
+		"SetNodeCreditLimit",
+		"SetGovernance",
+		"CreateNDIDProposal",
+		"ApproveNDIDProposal":
 		return app.checkIsNDID(param, nodeID)
 	case "RegisterIdentity",
 		"AddAccessor",
 		"CreateIdpResponse",
+		"UpdateIdpResponse",
+		"WithdrawIdpResponse",
 		"RegisterAccessor",
 		"UpdateIdentity",
 		"ClearRegisterIdentityTimeout",
@@ -601,7 +703,9 @@ func (app *ABCIApplication) callCheckTx(name string, param string, nodeID string
 		"RevokeIdentityAssociation",
 		"UpdateIdentityModeList",
 		"AddIdentity",
-		"RevokeAndAddAccessor":
+		"RevokeAndAddAccessor",
+		"ActivateIdentity",
+		"DeactivateIdentity":
 		return app.checkIsIDP(param, nodeID)
 	case "SignData",
 		"RegisterServiceDestination",
@@ -611,8 +715,12 @@ func (app *ABCIApplication) callCheckTx(name string, param string, nodeID string
 		return app.checkIsAS(param, nodeID)
 	case "CreateRequest":
 		return app.checkIsRPorIdP(param, nodeID)
+	case "MergeReferenceGroup":
+		return app.checkIsNDIDorIdP(param, nodeID)
 	case "SetMqAddresses":
 		return app.checkTxSetMqAddresses(param, nodeID)
+	case "TransferToken":
+		return app.checkTxTransferToken(param, nodeID)
 	default:
 		return types.ResponseCheckTx{Code: code.UnknownMethod, Log: "Unknown method name"}
 	}
@@ -648,7 +756,3 @@ func (app *ABCIApplication) checkIsProxyNode(nodeID string) bool {
 	}
 	return true
 }
-
-func (app *ABCIApplication) isDuplicateNonce(nonce []byte) bool {
-	return app.state.Has(nonce, false)
-}
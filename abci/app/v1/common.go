@@ -24,6 +24,7 @@ package app
 
 import (
 	"encoding/json"
+	"strconv"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
@@ -32,6 +33,7 @@ import (
 	"github.com/ndidplatform/smart-contract/v4/abci/code"
 	"github.com/ndidplatform/smart-contract/v4/abci/utils"
 	"github.com/ndidplatform/smart-contract/v4/protos/data"
+	protoTm "github.com/ndidplatform/smart-contract/v4/protos/tendermint"
 )
 
 var modeFunctionMap = map[string]bool{
@@ -50,6 +52,20 @@ var (
 	lastBlockKeyBytes    = []byte("lastBlock")
 	idpListKeyBytes      = []byte("IdPList")
 	allNamespaceKeyBytes = []byte("AllNamespace")
+
+	versionPruningPolicyKeyBytes              = []byte("VersionPruningPolicy")
+	versionPruningCursorKeyBytes              = []byte("VersionPruningCursor")
+	requestTimeoutIndexBuiltKeyBytes          = []byte("RequestTimeoutIndexBuilt")
+	requestTimeoutIndexCursorKeyBytes         = []byte("RequestTimeoutIndexCursor")
+	requestTimeoutIndexBackfillHeightKeyBytes = []byte("RequestTimeoutIndexBackfillHeight")
+	timeOutBlockRegisterIdentityKeyBytes      = []byte("TimeOutBlockRegisterIdentity")
+	minimumSignatureSchemeKeyBytes            = []byte("MinimumSignatureScheme")
+	governanceKeyBytes                        = []byte("Governance")
+	idpResponseFeeKeyBytes                    = []byte("IdPResponseFee")
+	tokenTransferPolicyKeyBytes               = []byte("TokenTransferPolicy")
+	tokenDecimalsKeyBytes                     = []byte("TokenDecimals")
+	legacyNonceMigratedKeyBytes               = []byte("LegacyNonceMigrated")
+	legacyNonceMigrationCursorKeyBytes        = []byte("LegacyNonceMigrationCursor")
 )
 
 const (
@@ -68,8 +84,72 @@ const (
 	allowedModeListKeyPrefix    = "AllowedModeList"
 	requestKeyPrefix            = "Request"
 	dataSignatureKeyPrefix      = "SignData"
+	requestTimeoutKeyPrefix     = "RequestTimeout"
+	pendingIdentityKeyPrefix    = "PendingRegisterIdentity"
+	registerTimeoutKeyPrefix    = "RegisterIdentityTimeout"
+	ndidProposalKeyPrefix       = "NDIDProposal"
+	proposalDeadlineKeyPrefix   = "NDIDProposalDeadline"
+	nonceKeyPrefix              = "Nonce"
+	nonceExpireKeyPrefix        = "NonceExpire"
+	nodeKeyHistoryKeyPrefix     = "NodeKeyHistory"
+	nodeKeyActivationKeyPrefix  = "NodeKeyActivation"
+	dataSignatureBlockKeyPrefix = "SignDataBlockHeight"
+	nodeDelegateKeyPrefix       = "NodeDelegateKey"
+	tokenLedgerKeyPrefix        = "TokenLedger"
+	feePolicyKeyPrefix          = "FeePolicy"
+	priceFuncScheduleKeyPrefix  = "PriceFuncSchedule"
+	billingPeriodKeyPrefix      = "BillingPeriod"
+)
+
+// Every change of these keys is kept as a new version (see AppState.SetVersioned)
+// so that queries can read them at any height
+var (
+	versionedKeys = map[string]bool{
+		string(masterNDIDKeyBytes):   true,
+		string(idpListKeyBytes):      true,
+		"rpList":                     true,
+		"asList":                     true,
+		"allList":                    true,
+		string(allNamespaceKeyBytes): true,
+		"AllService":                 true,
+	}
+	versionedKeyPrefixes = []string{
+		nodeIDKeyPrefix + keySeparator,
+		behindProxyNodeKeyPrefix + keySeparator,
+		tokenKeyPrefix + keySeparator,
+		tokenPriceFuncKeyPrefix + keySeparator,
+		serviceKeyPrefix + keySeparator,
+		serviceDestinationKeyPrefix + keySeparator,
+		approvedServiceKeyPrefix + keySeparator,
+		providedServicesKeyPrefix + keySeparator,
+		refGroupCodeKeyPrefix + keySeparator,
+		identityToRefCodeKeyPrefix + keySeparator,
+		accessorToRefCodeKeyPrefix + keySeparator,
+	}
 )
 
+func isVersionedKey(key []byte) bool {
+	if versionedKeys[string(key)] {
+		return true
+	}
+	for _, prefix := range versionedKeyPrefixes {
+		if strings.HasPrefix(string(key), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isVersionsKey reports whether key is the versions index of a versioned key
+// (see AppState.SetVersioned)
+func isVersionsKey(key []byte) bool {
+	if !strings.HasSuffix(string(key), "|versions") {
+		return false
+	}
+	versionedKey := strings.TrimSuffix(string(key), "|versions")
+	return isVersionedKey([]byte(versionedKey)) || strings.HasPrefix(versionedKey, requestKeyPrefix+keySeparator)
+}
+
 func (app *ABCIApplication) setMqAddresses(param string, nodeID string) types.ResponseDeliverTx {
 	app.logger.Infof("SetMqAddresses, Parameter: %s", param)
 	var funcParam SetMqAddressesParam
@@ -124,6 +204,7 @@ func (app *ABCIApplication) getNodeMasterPublicKey(param string) types.ResponseQ
 		return app.ReturnQuery(nil, err.Error(), app.state.Height)
 	}
 	res.MasterPublicKey = nodeDetail.MasterPublicKey
+	res.MasterPublicKeyAlgorithm = nodeDetail.MasterPublicKeyAlgorithm
 	valueJSON, err := json.Marshal(res)
 	if err != nil {
 		return app.ReturnQuery(nil, err.Error(), app.state.Height)
@@ -155,6 +236,7 @@ func (app *ABCIApplication) getNodePublicKey(param string) types.ResponseQuery {
 		return app.ReturnQuery(nil, err.Error(), app.state.Height)
 	}
 	res.PublicKey = nodeDetail.PublicKey
+	res.PublicKeyAlgorithm = nodeDetail.PublicKeyAlgorithm
 	valueJSON, err := json.Marshal(res)
 	if err != nil {
 		return app.ReturnQuery(nil, err.Error(), app.state.Height)
@@ -244,7 +326,7 @@ func (app *ABCIApplication) getIdpNodes(param string) types.ResponseQuery {
 	} else {
 		refGroupCode := ""
 		if funcParam.ReferenceGroupCode != "" {
-			refGroupCode = funcParam.ReferenceGroupCode
+			refGroupCode = app.resolveMergedRefGroupCode(funcParam.ReferenceGroupCode, true)
 		} else {
 			identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + funcParam.IdentityNamespace + keySeparator + funcParam.IdentityIdentifierHash
 			refGroupCodeFromDB, _ := app.state.Get([]byte(identityToRefCodeKey), true)
@@ -441,6 +523,7 @@ func (app *ABCIApplication) getAsNodesByServiceId(param string) types.ResponseQu
 			storedData.Node[index].MinIal,
 			storedData.Node[index].MinAal,
 			storedData.Node[index].SupportedNamespaceList,
+			formatTokenAmount(storedData.Node[index].Price, app.getTokenDecimals(true)),
 		}
 		result.Node = append(result.Node, newRow)
 	}
@@ -497,7 +580,10 @@ func (app *ABCIApplication) getRequest(param string, height int64) types.Respons
 		return app.ReturnQuery(nil, err.Error(), app.state.Height)
 	}
 	key := requestKeyPrefix + keySeparator + funcParam.RequestID
-	value, _ := app.state.GetVersioned([]byte(key), height, true)
+	value, err := app.state.GetVersioned([]byte(key), height, true)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
 
 	if value == nil {
 		valueJSON := []byte("{}")
@@ -531,7 +617,10 @@ func (app *ABCIApplication) getRequestDetail(param string, height int64, committ
 	}
 
 	key := requestKeyPrefix + keySeparator + funcParam.RequestID
-	value, _ := app.state.GetVersioned([]byte(key), height, committedState)
+	value, err := app.state.GetVersioned([]byte(key), height, committedState)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
 	if value == nil {
 		valueJSON := []byte("{}")
 		return app.ReturnQuery(valueJSON, "not found", app.state.Height)
@@ -622,6 +711,19 @@ func (app *ABCIApplication) getRequestDetail(param string, height int64, committ
 	// Set creation_chain_id
 	result.CreationChainID = request.ChainId
 
+	result.ResponseHistory = make([]ResponseHistory, 0, len(request.ResponseHistory))
+	for _, history := range request.ResponseHistory {
+		result.ResponseHistory = append(result.ResponseHistory, ResponseHistory{
+			Action:      history.Action,
+			BlockHeight: history.BlockHeight,
+			Ial:         history.Response.GetIal(),
+			Aal:         history.Response.GetAal(),
+			Status:      history.Response.GetStatus(),
+			Signature:   history.Response.GetSignature(),
+			IdpID:       history.Response.GetIdpId(),
+		})
+	}
+
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
 		value = []byte("")
@@ -702,10 +804,16 @@ func (app *ABCIApplication) updateNode(param string, nodeID string) types.Respon
 	// update MasterPublicKey
 	if funcParam.MasterPublicKey != "" {
 		nodeDetail.MasterPublicKey = funcParam.MasterPublicKey
+		nodeDetail.MasterPublicKeyAlgorithm = getPublicKeyAlgorithm(funcParam.MasterPublicKey)
 	}
 	// update PublicKey
 	if funcParam.PublicKey != "" {
+		err = app.addNodeKey(nodeID, &nodeDetail, funcParam.PublicKey)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+		}
 		nodeDetail.PublicKey = funcParam.PublicKey
+		nodeDetail.PublicKeyAlgorithm = getPublicKeyAlgorithm(funcParam.PublicKey)
 	}
 	// update SupportedRequestMessageDataUrlTypeList and Role of node ID is IdP
 	if funcParam.SupportedRequestMessageDataUrlTypeList != nil && string(app.getRoleFromNodeID(nodeID)) == "IdP" {
@@ -736,7 +844,7 @@ func (app *ABCIApplication) checkExistingIdentity(param string) types.ResponseQu
 	}
 	refGroupCode := ""
 	if funcParam.ReferenceGroupCode != "" {
-		refGroupCode = funcParam.ReferenceGroupCode
+		refGroupCode = app.resolveMergedRefGroupCode(funcParam.ReferenceGroupCode, true)
 	} else {
 		identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + funcParam.IdentityNamespace + keySeparator + funcParam.IdentityIdentifierHash
 		refGroupCodeFromDB, _ := app.state.Get([]byte(identityToRefCodeKey), true)
@@ -803,6 +911,7 @@ func (app *ABCIApplication) getAccessorKey(param string) types.ResponseQuery {
 		for _, accessor := range idp.Accessors {
 			if accessor.AccessorId == funcParam.AccessorID {
 				result.AccessorPublicKey = accessor.AccessorPublicKey
+				result.AccessorPublicKeyAlgorithm = accessor.AccessorPublicKeyAlgorithm
 				result.Active = accessor.Active
 				break
 			}
@@ -1048,7 +1157,7 @@ func (app *ABCIApplication) getIdentityInfo(param string) types.ResponseQuery {
 	}
 	refGroupCode := ""
 	if funcParam.ReferenceGroupCode != "" {
-		refGroupCode = funcParam.ReferenceGroupCode
+		refGroupCode = app.resolveMergedRefGroupCode(funcParam.ReferenceGroupCode, true)
 	} else {
 		identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + funcParam.IdentityNamespace + keySeparator + funcParam.IdentityIdentifierHash
 		refGroupCodeFromDB, _ := app.state.Get([]byte(identityToRefCodeKey), true)
@@ -1110,6 +1219,21 @@ func (app *ABCIApplication) getDataSignature(param string) types.ResponseQuery {
 	}
 	var result GetDataSignatureResult
 	result.Signature = string(signDataValue)
+	// Data signed before block height is recorded has no signing keys
+	result.PublicKeys = make([]NodeKeyDetail, 0)
+	signDataBlockKey := dataSignatureBlockKeyPrefix + keySeparator + funcParam.NodeID + keySeparator + funcParam.ServiceID + keySeparator + funcParam.RequestID
+	signDataBlockValue, _ := app.state.Get([]byte(signDataBlockKey), true)
+	if signDataBlockValue != nil {
+		result.BlockHeight, err = strconv.ParseInt(string(signDataBlockValue), 10, 64)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), app.state.Height)
+		}
+		keys, err := app.getNodeKeysAtBlock(funcParam.NodeID, result.BlockHeight, true)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), app.state.Height)
+		}
+		result.PublicKeys = newNodeKeyDetails(keys)
+	}
 	returnValue, err := json.Marshal(result)
 	return app.ReturnQuery(returnValue, "success", app.state.Height)
 }
@@ -1310,7 +1434,7 @@ func (app *ABCIApplication) getIdpNodesInfo(param string) types.ResponseQuery {
 	} else {
 		refGroupCode := ""
 		if funcParam.ReferenceGroupCode != "" {
-			refGroupCode = funcParam.ReferenceGroupCode
+			refGroupCode = app.resolveMergedRefGroupCode(funcParam.ReferenceGroupCode, true)
 		} else {
 			identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + funcParam.IdentityNamespace + keySeparator + funcParam.IdentityIdentifierHash
 			refGroupCodeFromDB, _ := app.state.Get([]byte(identityToRefCodeKey), true)
@@ -2010,6 +2134,42 @@ func (app *ABCIApplication) GetNamespaceAllowedIdentifierCountMap(committedState
 	return result
 }
 
+func (app *ABCIApplication) GetNamespaceAllowedActiveIdentifierCountMap(committedState bool) (result map[string]int) {
+	result = make(map[string]int, 0)
+	allNamespaceValue, _ := app.state.Get(allNamespaceKeyBytes, committedState)
+	if allNamespaceValue == nil {
+		return result
+	}
+	var namespaces data.NamespaceList
+	err := proto.Unmarshal([]byte(allNamespaceValue), &namespaces)
+	if err != nil {
+		return result
+	}
+	for _, namespace := range namespaces.Namespaces {
+		if namespace.Active {
+			if namespace.AllowedActiveIdentifierCountInReferenceGroup == -1 {
+				result[namespace.Namespace] = 0
+			} else {
+				result[namespace.Namespace] = int(namespace.AllowedActiveIdentifierCountInReferenceGroup)
+			}
+		}
+	}
+	return result
+}
+
+func (app *ABCIApplication) getTimeOutBlockRegisterIdentityFromStateDB(committedState bool) int64 {
+	value, _ := app.state.Get(timeOutBlockRegisterIdentityKeyBytes, committedState)
+	if value == nil {
+		return 0
+	}
+	var timeOut data.TimeOutBlockRegisterIdentity
+	err := proto.Unmarshal(value, &timeOut)
+	if err != nil {
+		return 0
+	}
+	return timeOut.TimeOutBlock
+}
+
 func (app *ABCIApplication) GetAllowedMinIalForRegisterIdentityAtFirstIdp(param string) types.ResponseQuery {
 	app.logger.Infof("GetAllowedMinIalForRegisterIdentityAtFirstIdp, Parameter: %s", param)
 	var result GetAllowedMinIalForRegisterIdentityAtFirstIdpResult
@@ -2034,3 +2194,90 @@ func (app *ABCIApplication) GetAllowedMinIalForRegisterIdentityAtFirstIdpFromSta
 	}
 	return allowedMinIal.MinIal
 }
+
+func (app *ABCIApplication) GetVersionPruningPolicy(param string) types.ResponseQuery {
+	app.logger.Infof("GetVersionPruningPolicy, Parameter: %s", param)
+	policy := app.getVersionPruningPolicyFromStateDB(true)
+	var result GetVersionPruningPolicyResult
+	result.KeepLastVersions = policy.KeepLastVersions
+	result.KeepRecentBlocks = policy.KeepRecentBlocks
+	result.KeepOnlyFinalVersionOfClosedRequest = policy.KeepOnlyFinalVersionOfClosedRequest
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(returnValue, "success", app.state.Height)
+}
+
+func (app *ABCIApplication) getVersionPruningPolicyFromStateDB(committedState bool) *data.VersionPruningPolicy {
+	var policy data.VersionPruningPolicy
+	policyValue, _ := app.state.Get(versionPruningPolicyKeyBytes, committedState)
+	if policyValue == nil {
+		return &policy
+	}
+	err := proto.Unmarshal(policyValue, &policy)
+	if err != nil {
+		return &data.VersionPruningPolicy{}
+	}
+	return &policy
+}
+
+func (app *ABCIApplication) GetMinimumSignatureScheme(param string) types.ResponseQuery {
+	app.logger.Infof("GetMinimumSignatureScheme, Parameter: %s", param)
+	var result GetMinimumSignatureSchemeResult
+	result.SignatureScheme = app.getMinimumSignatureSchemeFromStateDB(true).String()
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(returnValue, "success", app.state.Height)
+}
+
+func (app *ABCIApplication) getMinimumSignatureSchemeFromStateDB(committedState bool) protoTm.SignatureScheme {
+	var minimumSignatureScheme data.MinimumSignatureScheme
+	minimumSignatureSchemeValue, _ := app.state.Get(minimumSignatureSchemeKeyBytes, committedState)
+	if minimumSignatureSchemeValue == nil {
+		return protoTm.SignatureScheme_PKCS1V15_SHA256_BASE64
+	}
+	err := proto.Unmarshal(minimumSignatureSchemeValue, &minimumSignatureScheme)
+	if err != nil {
+		return protoTm.SignatureScheme_PKCS1V15_SHA256_BASE64
+	}
+	return protoTm.SignatureScheme(minimumSignatureScheme.SignatureScheme)
+}
+
+// versionPruningSweepKeysPerBlock is the number of state keys visited in
+// each block by the sweep which prunes versioned keys not written recently
+const versionPruningSweepKeysPerBlock = 1000
+
+// pruneVersions applies version pruning policy to versioned keys written in
+// current block and to the next keys of committed state after sweep cursor
+func (app *ABCIApplication) pruneVersions() {
+	policy := app.getVersionPruningPolicyFromStateDB(false)
+	if policy.KeepLastVersions == 0 && policy.KeepRecentBlocks == 0 && !policy.KeepOnlyFinalVersionOfClosedRequest {
+		return
+	}
+	var isFinalVersion func(key string, value []byte) bool
+	if policy.KeepOnlyFinalVersionOfClosedRequest {
+		isFinalVersion = func(key string, value []byte) bool {
+			if !strings.HasPrefix(key, requestKeyPrefix+keySeparator) || value == nil {
+				return false
+			}
+			var request data.Request
+			err := proto.Unmarshal(value, &request)
+			if err != nil {
+				return false
+			}
+			return request.Closed || request.TimedOut
+		}
+	}
+	app.state.PruneVersions(policy, isFinalVersion)
+
+	cursor, _ := app.state.Get(versionPruningCursorKeyBytes, false)
+	nextCursor := app.state.SweepPruneVersions(policy, isFinalVersion, isVersionsKey, cursor, versionPruningSweepKeysPerBlock)
+	if nextCursor == nil {
+		app.state.Delete(versionPruningCursorKeyBytes)
+	} else {
+		app.state.Set(versionPruningCursorKeyBytes, nextCursor)
+	}
+}
@@ -0,0 +1,187 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+)
+
+// Balance status of token account
+const (
+	tokenBalanceStatusNormal     = "normal"
+	tokenBalanceStatusLowBalance = "low_balance"
+	tokenBalanceStatusOverLimit  = "over_limit"
+)
+
+var isTokenBalanceStatus = map[string]bool{
+	tokenBalanceStatusNormal:     true,
+	tokenBalanceStatusLowBalance: true,
+	tokenBalanceStatusOverLimit:  true,
+}
+
+// tokenBalanceStatus returns over_limit when balance is below credit limit
+// (e.g. after credit limit is lowered), low_balance when balance is below
+// low balance threshold and normal otherwise
+func tokenBalanceStatus(token *data.Token) string {
+	if token.MinorAmount < -token.CreditLimit {
+		return tokenBalanceStatusOverLimit
+	}
+	if token.MinorAmount < token.LowBalanceThreshold {
+		return tokenBalanceStatusLowBalance
+	}
+	return tokenBalanceStatusNormal
+}
+
+// addTokenBalanceStatusEvent emits event when balance status of token account
+// becomes low_balance or over_limit. Amount is debit which changed the status.
+func (app *ABCIApplication) addTokenBalanceStatusEvent(nodeID string, previousStatus string, token *data.Token, amount int64) {
+	status := tokenBalanceStatus(token)
+	if status == previousStatus {
+		return
+	}
+	switch status {
+	case tokenBalanceStatusLowBalance:
+		decimals := app.getTokenDecimals(false)
+		app.tokenEvents = append(app.tokenEvents, types.Event{
+			Type: "did.token_low_balance",
+			Attributes: []cmn.KVPair{
+				{Key: []byte("node_id"), Value: []byte(nodeID)},
+				{Key: []byte("balance"), Value: []byte(formatTokenAmount(token.MinorAmount, decimals))},
+				{Key: []byte("low_balance_threshold"), Value: []byte(formatTokenAmount(token.LowBalanceThreshold, decimals))},
+			},
+		})
+	case tokenBalanceStatusOverLimit:
+		app.addTokenOverLimitEvent(nodeID, token, amount)
+	}
+}
+
+// addTokenOverLimitEvent emits event when debit of amount is rejected because
+// balance would be below credit limit or when balance is below credit limit
+func (app *ABCIApplication) addTokenOverLimitEvent(nodeID string, token *data.Token, amount int64) {
+	decimals := app.getTokenDecimals(false)
+	app.tokenEvents = append(app.tokenEvents, types.Event{
+		Type: "did.token_over_limit",
+		Attributes: []cmn.KVPair{
+			{Key: []byte("node_id"), Value: []byte(nodeID)},
+			{Key: []byte("balance"), Value: []byte(formatTokenAmount(token.MinorAmount, decimals))},
+			{Key: []byte("credit_limit"), Value: []byte(formatTokenAmount(token.CreditLimit, decimals))},
+			{Key: []byte("amount"), Value: []byte(formatTokenAmount(amount, decimals))},
+		},
+	})
+}
+
+// takeTokenEvents returns token balance status events emitted since last call
+func (app *ABCIApplication) takeTokenEvents() []types.Event {
+	events := app.tokenEvents
+	app.tokenEvents = nil
+	return events
+}
+
+func (app *ABCIApplication) setNodeCreditLimit(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("SetNodeCreditLimit, Parameter: %s", param)
+	var funcParam SetNodeCreditLimitParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	decimals := app.getTokenDecimals(false)
+	creditLimit, err := parseTokenAmount(funcParam.CreditLimit, decimals)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.InvalidTokenAmount, err.Error(), "")
+	}
+	if creditLimit < 0 {
+		return app.ReturnDeliverTxLog(code.CreditLimitMustBeGreaterOrEqualToZero, "Credit limit must be greater than or equal to zero", "")
+	}
+	lowBalanceThreshold, err := parseTokenAmount(funcParam.LowBalanceThreshold, decimals)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.InvalidTokenAmount, err.Error(), "")
+	}
+	if lowBalanceThreshold < 0 {
+		return app.ReturnDeliverTxLog(code.LowBalanceThresholdMustBeGreaterOrEqualToZero, "Low balance threshold must be greater than or equal to zero", "")
+	}
+	token, err := app.getTokenAccount(funcParam.NodeID, false)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, err.Error(), "")
+	}
+	status := tokenBalanceStatus(token)
+	token.CreditLimit = creditLimit
+	token.LowBalanceThreshold = lowBalanceThreshold
+	err = app.setTokenAccount(funcParam.NodeID, token)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.addTokenBalanceStatusEvent(funcParam.NodeID, status, token, 0)
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+func (app *ABCIApplication) getNodesByBalanceStatus(param string) types.ResponseQuery {
+	app.logger.Infof("GetNodesByBalanceStatus, Parameter: %s", param)
+	var funcParam GetNodesByBalanceStatusParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	if funcParam.Status != "" && !isTokenBalanceStatus[funcParam.Status] {
+		return app.ReturnQuery(nil, "Invalid balance status", app.state.Height)
+	}
+	var result GetNodesByBalanceStatusResult
+	result.Nodes = make([]NodeBalance, 0)
+	allValue, _ := app.state.Get([]byte("allList"), true)
+	if allValue != nil {
+		var allList data.AllList
+		err = proto.Unmarshal(allValue, &allList)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), app.state.Height)
+		}
+		decimals := app.getTokenDecimals(true)
+		for _, nodeID := range allList.NodeId {
+			token, err := app.getTokenAccount(nodeID, true)
+			if err != nil {
+				continue
+			}
+			status := tokenBalanceStatus(token)
+			if funcParam.Status != "" && status != funcParam.Status {
+				continue
+			}
+			result.Nodes = append(result.Nodes, NodeBalance{
+				NodeID:              nodeID,
+				Balance:             formatTokenAmount(token.MinorAmount, decimals),
+				CreditLimit:         formatTokenAmount(token.CreditLimit, decimals),
+				LowBalanceThreshold: formatTokenAmount(token.LowBalanceThreshold, decimals),
+				Status:              status,
+			})
+		}
+	}
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(value, "success", app.state.Height)
+}
@@ -32,7 +32,8 @@ type GetNodePublicKeyParam struct {
 }
 
 type GetNodePublicKeyResult struct {
-	PublicKey string `json:"public_key"`
+	PublicKey          string `json:"public_key"`
+	PublicKeyAlgorithm string `json:"public_key_algorithm"`
 }
 
 type GetNodeMasterPublicKeyParam struct {
@@ -40,7 +41,8 @@ type GetNodeMasterPublicKeyParam struct {
 }
 
 type GetNodeMasterPublicKeyResult struct {
-	MasterPublicKey string `json:"master_public_key"`
+	MasterPublicKey          string `json:"master_public_key"`
+	MasterPublicKeyAlgorithm string `json:"master_public_key_algorithm"`
 }
 
 type Identity struct {
@@ -152,6 +154,16 @@ type Response struct {
 	ValidSignature *bool   `json:"valid_signature"`
 }
 
+type ResponseHistory struct {
+	Action      string  `json:"action"`
+	BlockHeight int64   `json:"block_height"`
+	Ial         float64 `json:"ial"`
+	Aal         float64 `json:"aal"`
+	Status      string  `json:"status"`
+	Signature   string  `json:"signature"`
+	IdpID       string  `json:"idp_id"`
+}
+
 type CreateIdpResponseParam struct {
 	Aal       float64 `json:"aal"`
 	Ial       float64 `json:"ial"`
@@ -160,6 +172,18 @@ type CreateIdpResponseParam struct {
 	Status    string  `json:"status"`
 }
 
+type UpdateIdpResponseParam struct {
+	Aal       float64 `json:"aal"`
+	Ial       float64 `json:"ial"`
+	RequestID string  `json:"request_id"`
+	Signature string  `json:"signature"`
+	Status    string  `json:"status"`
+}
+
+type WithdrawIdpResponseParam struct {
+	RequestID string `json:"request_id"`
+}
+
 type GetRequestParam struct {
 	RequestID string `json:"request_id"`
 }
@@ -172,22 +196,23 @@ type GetRequestResult struct {
 }
 
 type GetRequestDetailResult struct {
-	RequestID           string        `json:"request_id"`
-	MinIdp              int           `json:"min_idp"`
-	MinAal              float64       `json:"min_aal"`
-	MinIal              float64       `json:"min_ial"`
-	Timeout             int           `json:"request_timeout"`
-	IdPIDList           []string      `json:"idp_id_list"`
-	DataRequestList     []DataRequest `json:"data_request_list"`
-	MessageHash         string        `json:"request_message_hash"`
-	Responses           []Response    `json:"response_list"`
-	IsClosed            bool          `json:"closed"`
-	IsTimedOut          bool          `json:"timed_out"`
-	Purpose             string        `json:"purpose"`
-	Mode                int32         `json:"mode"`
-	RequesterNodeID     string        `json:"requester_node_id"`
-	CreationBlockHeight int64         `json:"creation_block_height"`
-	CreationChainID     string        `json:"creation_chain_id"`
+	RequestID           string            `json:"request_id"`
+	MinIdp              int               `json:"min_idp"`
+	MinAal              float64           `json:"min_aal"`
+	MinIal              float64           `json:"min_ial"`
+	Timeout             int               `json:"request_timeout"`
+	IdPIDList           []string          `json:"idp_id_list"`
+	DataRequestList     []DataRequest     `json:"data_request_list"`
+	MessageHash         string            `json:"request_message_hash"`
+	Responses           []Response        `json:"response_list"`
+	IsClosed            bool              `json:"closed"`
+	IsTimedOut          bool              `json:"timed_out"`
+	Purpose             string            `json:"purpose"`
+	Mode                int32             `json:"mode"`
+	RequesterNodeID     string            `json:"requester_node_id"`
+	CreationBlockHeight int64             `json:"creation_block_height"`
+	CreationChainID     string            `json:"creation_chain_id"`
+	ResponseHistory     []ResponseHistory `json:"response_history"`
 }
 
 type SignDataParam struct {
@@ -208,10 +233,11 @@ type DisableServiceParam struct {
 }
 
 type RegisterServiceDestinationParam struct {
-	MinAal                 float64  `json:"min_aal"`
-	MinIal                 float64  `json:"min_ial"`
-	ServiceID              string   `json:"service_id"`
-	SupportedNamespaceList []string `json:"supported_namespace_list"`
+	MinAal                 float64       `json:"min_aal"`
+	MinIal                 float64       `json:"min_ial"`
+	ServiceID              string        `json:"service_id"`
+	SupportedNamespaceList []string      `json:"supported_namespace_list"`
+	Price                  DecimalAmount `json:"price"`
 }
 
 type GetServiceDetailParam struct {
@@ -237,11 +263,12 @@ type GetAsNodesByServiceIdResult struct {
 }
 
 type ASNodeResult struct {
-	ID                     string   `json:"node_id"`
-	Name                   string   `json:"node_name"`
-	MinIal                 float64  `json:"min_ial"`
-	MinAal                 float64  `json:"min_aal"`
-	SupportedNamespaceList []string `json:"supported_namespace_list"`
+	ID                     string        `json:"node_id"`
+	Name                   string        `json:"node_name"`
+	MinIal                 float64       `json:"min_ial"`
+	MinAal                 float64       `json:"min_aal"`
+	SupportedNamespaceList []string      `json:"supported_namespace_list"`
+	Price                  DecimalAmount `json:"price"`
 }
 
 type GetAsNodesByServiceIdWithNameResult struct {
@@ -249,10 +276,11 @@ type GetAsNodesByServiceIdWithNameResult struct {
 }
 
 type InitNDIDParam struct {
-	NodeID           string `json:"node_id"`
-	PublicKey        string `json:"public_key"`
-	MasterPublicKey  string `json:"master_public_key"`
-	ChainHistoryInfo string `json:"chain_history_info"`
+	NodeID           string  `json:"node_id"`
+	PublicKey        string  `json:"public_key"`
+	MasterPublicKey  string  `json:"master_public_key"`
+	ChainHistoryInfo string  `json:"chain_history_info"`
+	TokenDecimals    *uint32 `json:"token_decimals"`
 }
 
 type TransferNDIDParam struct {
@@ -296,18 +324,18 @@ type MsqAddress struct {
 }
 
 type SetNodeTokenParam struct {
-	NodeID string  `json:"node_id"`
-	Amount float64 `json:"amount"`
+	NodeID string        `json:"node_id"`
+	Amount DecimalAmount `json:"amount"`
 }
 
 type AddNodeTokenParam struct {
-	NodeID string  `json:"node_id"`
-	Amount float64 `json:"amount"`
+	NodeID string        `json:"node_id"`
+	Amount DecimalAmount `json:"amount"`
 }
 
 type ReduceNodeTokenParam struct {
-	NodeID string  `json:"node_id"`
-	Amount float64 `json:"amount"`
+	NodeID string        `json:"node_id"`
+	Amount DecimalAmount `json:"amount"`
 }
 
 type GetNodeTokenParam struct {
@@ -315,26 +343,40 @@ type GetNodeTokenParam struct {
 }
 
 type GetNodeTokenResult struct {
-	Amount float64 `json:"amount"`
+	Amount              DecimalAmount `json:"amount"`
+	CreditLimit         DecimalAmount `json:"credit_limit"`
+	LowBalanceThreshold DecimalAmount `json:"low_balance_threshold"`
+	Status              string        `json:"status"`
 }
 
 type SetPriceFuncParam struct {
-	Func  string  `json:"func"`
-	Price float64 `json:"price"`
+	Func                 string        `json:"func"`
+	Price                DecimalAmount `json:"price"`
+	EffectiveBlockHeight int64         `json:"effective_block_height"`
+	Role                 string        `json:"role"`
+	NodeID               string        `json:"node_id"`
+	RemoveOverride       bool          `json:"remove_override"`
 }
 
 type GetPriceFuncParam struct {
-	Func string `json:"func"`
+	Func        string `json:"func"`
+	NodeID      string `json:"node_id"`
+	BlockHeight int64  `json:"block_height"`
 }
 
 type GetPriceFuncResult struct {
-	Price float64 `json:"price"`
+	Price                DecimalAmount `json:"price"`
+	EffectiveBlockHeight int64         `json:"effective_block_height"`
 }
 
-type Report struct {
-	Method string  `json:"method"`
-	Price  float64 `json:"price"`
-	Data   string  `json:"data"`
+type TokenLedgerEntry struct {
+	BlockHeight  int64         `json:"block_height"`
+	Method       string        `json:"method"`
+	Reason       string        `json:"reason"`
+	RequestID    string        `json:"request_id"`
+	Counterparty string        `json:"counterparty"`
+	Amount       DecimalAmount `json:"amount"`
+	Balance      DecimalAmount `json:"balance"`
 }
 
 type RequestIDParam struct {
@@ -407,8 +449,9 @@ type GetAccessorKeyParam struct {
 }
 
 type GetAccessorKeyResult struct {
-	AccessorPublicKey string `json:"accessor_public_key"`
-	Active            bool   `json:"active"`
+	AccessorPublicKey          string `json:"accessor_public_key"`
+	AccessorPublicKeyAlgorithm string `json:"accessor_public_key_algorithm"`
+	Active                     bool   `json:"active"`
 }
 
 type SetValidatorParam struct {
@@ -516,14 +559,17 @@ type GetDataSignatureParam struct {
 }
 
 type GetDataSignatureResult struct {
-	Signature string `json:"signature"`
+	Signature   string          `json:"signature"`
+	BlockHeight int64           `json:"block_height"`
+	PublicKeys  []NodeKeyDetail `json:"public_keys"`
 }
 
 type UpdateServiceDestinationParam struct {
-	ServiceID              string   `json:"service_id"`
-	MinIal                 float64  `json:"min_ial"`
-	MinAal                 float64  `json:"min_aal"`
-	SupportedNamespaceList []string `json:"supported_namespace_list"`
+	ServiceID              string         `json:"service_id"`
+	MinIal                 float64        `json:"min_ial"`
+	MinAal                 float64        `json:"min_aal"`
+	SupportedNamespaceList []string       `json:"supported_namespace_list"`
+	Price                  *DecimalAmount `json:"price"`
 }
 
 type UpdateServiceParam struct {
@@ -581,7 +627,8 @@ type DisableServiceDestinationParam struct {
 }
 
 type ClearRegisterIdentityTimeoutParam struct {
-	HashID string `json:"hash_id"`
+	AccessorID string `json:"accessor_id"`
+	RequestID  string `json:"request_id"`
 }
 
 type TimeOutBlockRegisterIdentity struct {
@@ -861,6 +908,86 @@ type GetAllowedMinIalForRegisterIdentityAtFirstIdpResult struct {
 	MinIal float64 `json:"min_ial"`
 }
 
+type SetVersionPruningPolicyParam struct {
+	KeepLastVersions                    int64 `json:"keep_last_versions"`
+	KeepRecentBlocks                    int64 `json:"keep_recent_blocks"`
+	KeepOnlyFinalVersionOfClosedRequest bool  `json:"keep_only_final_version_of_closed_request"`
+}
+
+type GetVersionPruningPolicyResult struct {
+	KeepLastVersions                    int64 `json:"keep_last_versions"`
+	KeepRecentBlocks                    int64 `json:"keep_recent_blocks"`
+	KeepOnlyFinalVersionOfClosedRequest bool  `json:"keep_only_final_version_of_closed_request"`
+}
+
+type SetMinimumSignatureSchemeParam struct {
+	SignatureScheme string `json:"signature_scheme"`
+}
+
+type GetMinimumSignatureSchemeResult struct {
+	SignatureScheme string `json:"signature_scheme"`
+}
+
+type GovernanceKey struct {
+	KeyID     string `json:"key_id"`
+	PublicKey string `json:"public_key"`
+}
+
+type SetGovernanceParam struct {
+	Keys                 []GovernanceKey `json:"keys"`
+	Threshold            int32           `json:"threshold"`
+	ProposalTimeoutBlock int64           `json:"proposal_timeout_block"`
+}
+
+type GovernanceKeyDetail struct {
+	KeyID              string `json:"key_id"`
+	PublicKey          string `json:"public_key"`
+	PublicKeyAlgorithm string `json:"public_key_algorithm"`
+}
+
+type GetGovernanceResult struct {
+	Keys                 []GovernanceKeyDetail `json:"keys"`
+	Threshold            int32                 `json:"threshold"`
+	ProposalTimeoutBlock int64                 `json:"proposal_timeout_block"`
+}
+
+type CreateNDIDProposalParam struct {
+	ProposalID string `json:"proposal_id"`
+	Method     string `json:"method"`
+	Params     string `json:"params"`
+}
+
+type ApproveNDIDProposalParam struct {
+	ProposalID string `json:"proposal_id"`
+	KeyID      string `json:"key_id"`
+	Signature  []byte `json:"signature"`
+}
+
+type BatchOperation struct {
+	Method string `json:"method"`
+	Params string `json:"params"`
+}
+
+type BatchParam struct {
+	Operations []BatchOperation `json:"operations"`
+}
+
+type GetNDIDProposalParam struct {
+	ProposalID string `json:"proposal_id"`
+}
+
+type GetNDIDProposalResult struct {
+	ProposalID          string   `json:"proposal_id"`
+	Method              string   `json:"method"`
+	Params              string   `json:"params"`
+	Approvals           []string `json:"approvals"`
+	CreationBlockHeight int64    `json:"creation_block_height"`
+	DeadlineBlock       int64    `json:"deadline_block"`
+	Status              string   `json:"status"`
+	ResultCode          uint32   `json:"result_code"`
+	ResultLog           string   `json:"result_log"`
+}
+
 type UpdateNamespaceParam struct {
 	Namespace                                    string `json:"namespace"`
 	Description                                  string `json:"description"`
@@ -875,3 +1002,196 @@ type RevokeAndAddAccessorParam struct {
 	AccessorType       string `json:"accessor_type"`
 	RequestID          string `json:"request_id"`
 }
+
+type ActivateIdentityParam struct {
+	IdentityNamespace      string `json:"identity_namespace"`
+	IdentityIdentifierHash string `json:"identity_identifier_hash"`
+	RequestID              string `json:"request_id"`
+}
+
+type DeactivateIdentityParam struct {
+	IdentityNamespace      string `json:"identity_namespace"`
+	IdentityIdentifierHash string `json:"identity_identifier_hash"`
+	RequestID              string `json:"request_id"`
+}
+
+type MergeReferenceGroupParam struct {
+	ReferenceGroupCode            string `json:"reference_group_code"`
+	IdentityNamespace             string `json:"identity_namespace"`
+	IdentityIdentifierHash        string `json:"identity_identifier_hash"`
+	ReferenceGroupCodeToMerge     string `json:"reference_group_code_to_merge"`
+	IdentityNamespaceToMerge      string `json:"identity_namespace_to_merge"`
+	IdentityIdentifierHashToMerge string `json:"identity_identifier_hash_to_merge"`
+	RequestID                     string `json:"request_id"`
+}
+
+type RotateNodeKeyParam struct {
+	PublicKey             string `json:"public_key"`
+	ActivationBlockHeight int64  `json:"activation_block_height"`
+	GracePeriodBlock      int64  `json:"grace_period_block"`
+}
+
+type GetNodeKeyHistoryParam struct {
+	NodeID string `json:"node_id"`
+}
+
+type NodeKeyDetail struct {
+	PublicKey          string `json:"public_key"`
+	PublicKeyAlgorithm string `json:"public_key_algorithm"`
+	ValidFromBlock     int64  `json:"valid_from_block"`
+	ValidToBlock       int64  `json:"valid_to_block"`
+}
+
+type GetNodeKeyHistoryResult struct {
+	Keys []NodeKeyDetail `json:"keys"`
+}
+
+type AddNodeDelegateKeyParam struct {
+	KeyID       string   `json:"key_id"`
+	PublicKey   string   `json:"public_key"`
+	Methods     []string `json:"methods"`
+	ExpiryBlock int64    `json:"expiry_block"`
+}
+
+type RemoveNodeDelegateKeyParam struct {
+	KeyID string `json:"key_id"`
+}
+
+type GetNodeDelegateKeysParam struct {
+	NodeID string `json:"node_id"`
+}
+
+type NodeDelegateKeyDetail struct {
+	KeyID              string   `json:"key_id"`
+	PublicKey          string   `json:"public_key"`
+	PublicKeyAlgorithm string   `json:"public_key_algorithm"`
+	Methods            []string `json:"methods"`
+	ExpiryBlock        int64    `json:"expiry_block"`
+}
+
+type GetNodeDelegateKeysResult struct {
+	Keys []NodeDelegateKeyDetail `json:"keys"`
+}
+
+type SetIdpResponseFeeParam struct {
+	Fee DecimalAmount `json:"fee"`
+}
+
+type GetIdpResponseFeeResult struct {
+	Fee DecimalAmount `json:"fee"`
+}
+
+type TransferTokenParam struct {
+	ToNodeID string        `json:"to_node_id"`
+	Amount   DecimalAmount `json:"amount"`
+}
+
+type OrganizationGroup struct {
+	GroupID    string   `json:"group_id"`
+	NodeIDList []string `json:"node_id_list"`
+}
+
+type SetTokenTransferPolicyParam struct {
+	Enabled            bool                `json:"enabled"`
+	AllowSameProxy     bool                `json:"allow_same_proxy"`
+	OrganizationGroups []OrganizationGroup `json:"organization_groups"`
+}
+
+type GetTokenTransferPolicyResult struct {
+	Enabled            bool                `json:"enabled"`
+	AllowSameProxy     bool                `json:"allow_same_proxy"`
+	OrganizationGroups []OrganizationGroup `json:"organization_groups"`
+}
+
+type GetTokenStatementParam struct {
+	NodeID          string `json:"node_id"`
+	FromBlockHeight int64  `json:"from_block_height"`
+	ToBlockHeight   int64  `json:"to_block_height"`
+	Offset          int    `json:"offset"`
+	Limit           int    `json:"limit"`
+}
+
+type GetTokenStatementResult struct {
+	Entries    []TokenLedgerEntry `json:"entries"`
+	TotalCount int                `json:"total_count"`
+}
+
+type GetTokenDecimalsResult struct {
+	Decimals uint32 `json:"decimals"`
+}
+
+type SetFeePolicyParam struct {
+	Method     string        `json:"method"`
+	Mode       string        `json:"mode"`
+	FailureFee DecimalAmount `json:"failure_fee"`
+}
+
+type GetFeePolicyParam struct {
+	Method string `json:"method"`
+}
+
+type GetFeePolicyResult struct {
+	Method     string        `json:"method"`
+	Mode       string        `json:"mode"`
+	FailureFee DecimalAmount `json:"failure_fee"`
+}
+
+type GetPriceFuncScheduleParam struct {
+	Func string `json:"func"`
+}
+
+type PriceFuncScheduleEntry struct {
+	EffectiveBlockHeight int64         `json:"effective_block_height"`
+	Role                 string        `json:"role"`
+	NodeID               string        `json:"node_id"`
+	Price                DecimalAmount `json:"price"`
+	RemoveOverride       bool          `json:"remove_override"`
+}
+
+type GetPriceFuncScheduleResult struct {
+	Entries []PriceFuncScheduleEntry `json:"entries"`
+}
+
+type SetNodeCreditLimitParam struct {
+	NodeID              string        `json:"node_id"`
+	CreditLimit         DecimalAmount `json:"credit_limit"`
+	LowBalanceThreshold DecimalAmount `json:"low_balance_threshold"`
+}
+
+type GetNodesByBalanceStatusParam struct {
+	Status string `json:"status"`
+}
+
+type NodeBalance struct {
+	NodeID              string        `json:"node_id"`
+	Balance             DecimalAmount `json:"balance"`
+	CreditLimit         DecimalAmount `json:"credit_limit"`
+	LowBalanceThreshold DecimalAmount `json:"low_balance_threshold"`
+	Status              string        `json:"status"`
+}
+
+type GetNodesByBalanceStatusResult struct {
+	Nodes []NodeBalance `json:"nodes"`
+}
+
+type GetUsageReportParam struct {
+	NodeID        string `json:"node_id"`
+	BillingPeriod string `json:"billing_period"`
+	Offset        int    `json:"offset"`
+	Limit         int    `json:"limit"`
+}
+
+type UsageReportEntry struct {
+	BlockHeight int64         `json:"block_height"`
+	Method      string        `json:"method"`
+	RequestID   string        `json:"request_id"`
+	Price       DecimalAmount `json:"price"`
+}
+
+type GetUsageReportResult struct {
+	NodeID        string             `json:"node_id"`
+	BillingPeriod string             `json:"billing_period"`
+	Entries       []UsageReportEntry `json:"entries"`
+	TotalCount    int                `json:"total_count"`
+	TotalPrice    DecimalAmount      `json:"total_price"`
+}
@@ -0,0 +1,215 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/abci/utils"
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+)
+
+func nodeDelegateKeyListKey(nodeID string) []byte {
+	return []byte(nodeDelegateKeyPrefix + keySeparator + nodeID)
+}
+
+func (app *ABCIApplication) getNodeDelegateKeyList(nodeID string, committedState bool) (*data.NodeDelegateKeyList, error) {
+	var keyList data.NodeDelegateKeyList
+	keyListValue, _ := app.state.Get(nodeDelegateKeyListKey(nodeID), committedState)
+	if keyListValue == nil {
+		return &keyList, nil
+	}
+	err := proto.Unmarshal(keyListValue, &keyList)
+	if err != nil {
+		return nil, err
+	}
+	return &keyList, nil
+}
+
+func (app *ABCIApplication) setNodeDelegateKeyList(nodeID string, keyList *data.NodeDelegateKeyList) error {
+	if len(keyList.Keys) == 0 {
+		app.state.Delete(nodeDelegateKeyListKey(nodeID))
+		return nil
+	}
+	keyListValue, err := utils.ProtoDeterministicMarshal(keyList)
+	if err != nil {
+		return err
+	}
+	app.state.Set(nodeDelegateKeyListKey(nodeID), keyListValue)
+	return nil
+}
+
+// isDelegatableMethod reports whether Tx of method can be signed with delegate key.
+// Methods which must be signed with master key or have no node key cannot.
+func isDelegatableMethod(method string) bool {
+	return IsMethod[method] && !IsMasterKeyMethod[method] && method != "InitNDID"
+}
+
+func (app *ABCIApplication) addNodeDelegateKey(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("AddNodeDelegateKey, Parameter: %s", param)
+	var funcParam AddNodeDelegateKeyParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if funcParam.KeyID == "" {
+		return app.ReturnDeliverTxLog(code.DelegateKeyIDCannotBeEmpty, "Delegate key ID cannot be empty", "")
+	}
+	if len(funcParam.Methods) == 0 {
+		return app.ReturnDeliverTxLog(code.InvalidDelegateKeyMethod, "Delegate key methods cannot be empty", "")
+	}
+	for _, method := range funcParam.Methods {
+		if !isDelegatableMethod(method) {
+			return app.ReturnDeliverTxLog(code.InvalidDelegateKeyMethod, "Method cannot be signed with delegate key: "+method, "")
+		}
+	}
+	if funcParam.ExpiryBlock != 0 && funcParam.ExpiryBlock <= app.state.CurrentBlockHeight {
+		return app.ReturnDeliverTxLog(code.InvalidDelegateKeyExpiryBlock, "Expiry block must be greater than current block height", "")
+	}
+	keyList, err := app.getNodeDelegateKeyList(nodeID, false)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	for _, key := range keyList.Keys {
+		if key.KeyId == funcParam.KeyID {
+			return app.ReturnDeliverTxLog(code.DuplicateDelegateKeyID, "Duplicate delegate key ID", "")
+		}
+	}
+	keyList.Keys = append(keyList.Keys, &data.NodeDelegateKey{
+		KeyId:              funcParam.KeyID,
+		PublicKey:          funcParam.PublicKey,
+		PublicKeyAlgorithm: getPublicKeyAlgorithm(funcParam.PublicKey),
+		Methods:            funcParam.Methods,
+		ExpiryBlock:        funcParam.ExpiryBlock,
+	})
+	err = app.setNodeDelegateKeyList(nodeID, keyList)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+func (app *ABCIApplication) removeNodeDelegateKey(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("RemoveNodeDelegateKey, Parameter: %s", param)
+	var funcParam RemoveNodeDelegateKeyParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	keyList, err := app.getNodeDelegateKeyList(nodeID, false)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	keys := make([]*data.NodeDelegateKey, 0, len(keyList.Keys))
+	for _, key := range keyList.Keys {
+		if key.KeyId != funcParam.KeyID {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == len(keyList.Keys) {
+		return app.ReturnDeliverTxLog(code.DelegateKeyNotFound, "Delegate key not found", "")
+	}
+	keyList.Keys = keys
+	err = app.setNodeDelegateKeyList(nodeID, keyList)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+// getDelegatePublicKey returns delegate key of node which Tx of method is signed with.
+// Batch can be signed with delegate key which scope has Batch and every operation method.
+func (app *ABCIApplication) getDelegatePublicKey(method string, param string, nodeID string, keyID string, committedState bool) (string, string, uint32, string) {
+	if !isDelegatableMethod(method) {
+		return "", "", code.MethodIsNotInDelegateKeyScope, "Method cannot be signed with delegate key"
+	}
+	keyList, err := app.getNodeDelegateKeyList(nodeID, committedState)
+	if err != nil {
+		return "", "", code.UnmarshalError, err.Error()
+	}
+	var delegateKey *data.NodeDelegateKey
+	for _, key := range keyList.Keys {
+		if key.KeyId == keyID {
+			delegateKey = key
+			break
+		}
+	}
+	if delegateKey == nil {
+		return "", "", code.DelegateKeyNotFound, "Delegate key not found"
+	}
+	if delegateKey.ExpiryBlock != 0 && delegateKey.ExpiryBlock < app.getTxBlockHeight(committedState) {
+		return "", "", code.DelegateKeyIsExpired, "Delegate key is expired"
+	}
+	scope := make(map[string]bool, len(delegateKey.Methods))
+	for _, scopeMethod := range delegateKey.Methods {
+		scope[scopeMethod] = true
+	}
+	if !scope[method] {
+		return "", "", code.MethodIsNotInDelegateKeyScope, "Method is not in delegate key scope"
+	}
+	if method == "Batch" {
+		// Invalid batch is rejected by Batch itself
+		funcParam, retCode, _ := parseBatchParam(param)
+		if retCode == code.OK {
+			for _, operation := range funcParam.Operations {
+				if !scope[operation.Method] {
+					return "", "", code.MethodIsNotInDelegateKeyScope, "Batch operation method is not in delegate key scope"
+				}
+			}
+		}
+	}
+	return delegateKey.PublicKey, delegateKey.PublicKeyAlgorithm, code.OK, ""
+}
+
+func (app *ABCIApplication) getNodeDelegateKeysQuery(param string) types.ResponseQuery {
+	app.logger.Infof("GetNodeDelegateKeys, Parameter: %s", param)
+	var funcParam GetNodeDelegateKeysParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	keyList, err := app.getNodeDelegateKeyList(funcParam.NodeID, true)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	var result GetNodeDelegateKeysResult
+	result.Keys = make([]NodeDelegateKeyDetail, 0, len(keyList.Keys))
+	for _, key := range keyList.Keys {
+		result.Keys = append(result.Keys, NodeDelegateKeyDetail{
+			KeyID:              key.KeyId,
+			PublicKey:          key.PublicKey,
+			PublicKeyAlgorithm: key.PublicKeyAlgorithm,
+			Methods:            key.Methods,
+			ExpiryBlock:        key.ExpiryBlock,
+		})
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(returnValue, "success", app.state.Height)
+}
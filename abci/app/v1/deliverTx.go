@@ -88,7 +88,22 @@ func (app *ABCIApplication) ReturnDeliverTxLogWithAttributes(code uint32, log st
 }
 
 // DeliverTxRouter is Pointer to function
-func (app *ABCIApplication) DeliverTxRouter(method string, param string, nonce []byte, signature []byte, nodeID string) types.ResponseDeliverTx {
+func (app *ABCIApplication) DeliverTxRouter(method string, param string, nonce []byte, signature []byte, nodeID string, validUntilBlock int64) types.ResponseDeliverTx {
+	var result types.ResponseDeliverTx
+	if method == "Batch" {
+		result = app.deliverTxBatch(param, nonce, signature, nodeID)
+	} else {
+		result = app.deliverTxOperation(method, param, nonce, signature, nodeID)
+	}
+
+	// Set used nonce to stateDB
+	app.setNonce(nonce, validUntilBlock)
+	return result
+}
+
+// deliverTxOperation checks authorization of a single method call,
+// executes it and charges fee from caller according to fee policy
+func (app *ABCIApplication) deliverTxOperation(method string, param string, nonce []byte, signature []byte, nodeID string) types.ResponseDeliverTx {
 	// ---- check authorization ----
 	checkTxResult := app.CheckTxRouter(method, param, nonce, signature, nodeID, false)
 	if checkTxResult.Code != code.OK {
@@ -98,25 +113,54 @@ func (app *ABCIApplication) DeliverTxRouter(method string, param string, nonce [
 		return app.ReturnDeliverTxLog(checkTxResult.Code, "Unauthorized", "")
 	}
 
+	checkpoint := app.checkpoint()
 	result := app.callDeliverTx(method, param, nodeID)
-	// ---- Burn token ----
+	// ---- Charge fee ----
 	if !app.checkNDID(param, nodeID, false) && !isNDIDMethod[method] {
-		needToken := app.getTokenPriceByFunc(method, false)
-		errCode, errLog := app.reduceToken(nodeID, needToken)
-		if errCode != code.OK {
-			result.Code = errCode
-			result.Log = errLog
+		success := result.Code == code.OK
+		app.chargeTxFee(method, param, nodeID, &result)
+		// Caller cannot pay fee after Tx is executed (e.g. token is escrowed
+		// or transferred by Tx). Changes of Tx are discarded and Tx is charged
+		// as a failed Tx.
+		if success && result.Code != code.OK {
+			app.rollback(checkpoint)
+			result = app.ReturnDeliverTxLog(result.Code, result.Log, "")
+			app.chargeTxFee(method, param, nodeID, &result)
 		}
 	}
-
-	// Set used nonce to stateDB
-	emptyValue := make([]byte, 0)
-	app.state.Set([]byte(nonce), emptyValue)
-	nonceStr := string(nonce)
-	app.deliverTxNonceState[nonceStr] = []byte(nil)
+	result.Events = append(result.Events, app.takeTokenEvents()...)
 	return result
 }
 
+// txCheckpoint is a copy of uncommitted changes of current block which can
+// be restored with rollback to discard changes made after the checkpoint
+type txCheckpoint struct {
+	state            StateCheckpoint
+	valUpdates       map[string]types.ValidatorUpdate
+	tokenLedgerIndex int64
+}
+
+func (app *ABCIApplication) checkpoint() txCheckpoint {
+	valUpdates := make(map[string]types.ValidatorUpdate, len(app.valUpdates))
+	for key, valUpdate := range app.valUpdates {
+		valUpdates[key] = valUpdate
+	}
+	return txCheckpoint{
+		state:            app.state.Checkpoint(),
+		valUpdates:       valUpdates,
+		tokenLedgerIndex: app.tokenLedgerIndex,
+	}
+}
+
+// rollback restores uncommitted changes to checkpoint and discards token
+// events which are not taken yet
+func (app *ABCIApplication) rollback(checkpoint txCheckpoint) {
+	app.state.Rollback(checkpoint.state)
+	app.valUpdates = checkpoint.valUpdates
+	app.tokenLedgerIndex = checkpoint.tokenLedgerIndex
+	app.tokenEvents = nil
+}
+
 func (app *ABCIApplication) callDeliverTx(name string, param string, nodeID string) types.ResponseDeliverTx {
 	switch name {
 	case "InitNDID":
@@ -131,6 +175,10 @@ func (app *ABCIApplication) callDeliverTx(name string, param string, nodeID stri
 		return app.createRequest(param, nodeID)
 	case "CreateIdpResponse":
 		return app.createIdpResponse(param, nodeID)
+	case "UpdateIdpResponse":
+		return app.updateIdpResponse(param, nodeID)
+	case "WithdrawIdpResponse":
+		return app.withdrawIdpResponse(param, nodeID)
 	case "SignData":
 		return app.signData(param, nodeID)
 	case "RegisterServiceDestination":
@@ -153,6 +201,12 @@ func (app *ABCIApplication) callDeliverTx(name string, param string, nodeID stri
 		return app.addNamespace(param, nodeID)
 	case "UpdateNode":
 		return app.updateNode(param, nodeID)
+	case "RotateNodeKey":
+		return app.rotateNodeKey(param, nodeID)
+	case "AddNodeDelegateKey":
+		return app.addNodeDelegateKey(param, nodeID)
+	case "RemoveNodeDelegateKey":
+		return app.removeNodeDelegateKey(param, nodeID)
 	case "SetValidator":
 		return app.setValidator(param, nodeID)
 	case "AddService":
@@ -191,6 +245,8 @@ func (app *ABCIApplication) callDeliverTx(name string, param string, nodeID stri
 		return app.enableServiceDestination(param, nodeID)
 	case "SetTimeOutBlockRegisterIdentity":
 		return app.setTimeOutBlockRegisterIdentity(param, nodeID)
+	case "ClearRegisterIdentityTimeout":
+		return app.clearRegisterIdentityTimeout(param, nodeID)
 	case "AddNodeToProxyNode":
 		return app.addNodeToProxyNode(param, nodeID)
 	case "UpdateNodeProxyNode":
@@ -219,6 +275,32 @@ func (app *ABCIApplication) callDeliverTx(name string, param string, nodeID stri
 		return app.SetAllowedMinIalForRegisterIdentityAtFirstIdp(param, nodeID)
 	case "RevokeAndAddAccessor":
 		return app.revokeAndAddAccessor(param, nodeID)
+	case "SetVersionPruningPolicy":
+		return app.SetVersionPruningPolicy(param, nodeID)
+	case "SetMinimumSignatureScheme":
+		return app.SetMinimumSignatureScheme(param, nodeID)
+	case "SetIdpResponseFee":
+		return app.setIdpResponseFee(param, nodeID)
+	case "SetTokenTransferPolicy":
+		return app.setTokenTransferPolicy(param, nodeID)
+	case "SetFeePolicy":
+		return app.setFeePolicy(param, nodeID)
+	case "SetNodeCreditLimit":
+		return app.setNodeCreditLimit(param, nodeID)
+	case "TransferToken":
+		return app.transferToken(param, nodeID)
+	case "SetGovernance":
+		return app.setGovernance(param, nodeID)
+	case "CreateNDIDProposal":
+		return app.createNDIDProposal(param, nodeID)
+	case "ApproveNDIDProposal":
+		return app.approveNDIDProposal(param, nodeID)
+	case "MergeReferenceGroup":
+		return app.mergeReferenceGroup(param, nodeID)
+	case "ActivateIdentity":
+		return app.activateIdentity(param, nodeID)
+	case "DeactivateIdentity":
+		return app.deactivateIdentity(param, nodeID)
 	default:
 		return types.ResponseDeliverTx{Code: code.UnknownMethod, Log: "Unknown method name"}
 	}
@@ -0,0 +1,182 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/abci/utils"
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+)
+
+// Fee charging mode of method. Fee is price of function (SetPriceFunc).
+const (
+	// charge fee whether or not Tx succeeds (default)
+	feeChargingModeAlways = "always"
+	// charge fee only when Tx succeeds
+	feeChargingModeSuccessOnly = "success_only"
+	// charge fee when Tx succeeds and failure fee (at most fee) when Tx fails
+	feeChargingModeReducedOnFailure = "reduced_on_failure"
+	// never charge fee
+	feeChargingModeWaive = "waive"
+)
+
+var isFeeChargingMode = map[string]bool{
+	feeChargingModeAlways:           true,
+	feeChargingModeSuccessOnly:      true,
+	feeChargingModeReducedOnFailure: true,
+	feeChargingModeWaive:            true,
+}
+
+func (app *ABCIApplication) getFeePolicy(method string, committedState bool) *data.FeePolicy {
+	policy := data.FeePolicy{Mode: feeChargingModeAlways}
+	value, _ := app.state.Get([]byte(feePolicyKeyPrefix+keySeparator+method), committedState)
+	if value == nil {
+		return &policy
+	}
+	err := proto.Unmarshal(value, &policy)
+	if err != nil {
+		return &data.FeePolicy{Mode: feeChargingModeAlways}
+	}
+	return &policy
+}
+
+// getTxFee returns fee in minor unit charged from node for Tx of method which
+// succeeds or fails
+func (app *ABCIApplication) getTxFee(method string, nodeID string, success bool, committedState bool) int64 {
+	policy := app.getFeePolicy(method, committedState)
+	if policy.Mode == feeChargingModeWaive {
+		return 0
+	}
+	fee := app.getTokenPriceByFunc(method, nodeID, app.getTxBlockHeight(committedState), committedState)
+	if success {
+		return fee
+	}
+	switch policy.Mode {
+	case feeChargingModeSuccessOnly:
+		return 0
+	case feeChargingModeReducedOnFailure:
+		if policy.FailureFee < fee {
+			return policy.FailureFee
+		}
+	}
+	return fee
+}
+
+// chargeTxFee reduces fee of Tx from caller according to fee policy of method
+// (recorded in token ledger and usage report of caller) and reports the fee
+// actually charged as a "did.fee" event. Failed Tx keeps
+// its result code even if caller does not have enough token for the fee.
+// Successful Tx fails if its request ID cannot be read for the fee record.
+func (app *ABCIApplication) chargeTxFee(method string, param string, nodeID string, result *types.ResponseDeliverTx) {
+	success := result.Code == code.OK
+	fee := app.getTxFee(method, nodeID, success, false)
+	if fee > 0 {
+		// Fee of request related method is recorded with its request ID
+		var requestIDParam RequestIDParam
+		err := json.Unmarshal([]byte(param), &requestIDParam)
+		if err != nil && success {
+			result.Code = code.UnmarshalError
+			result.Log = err.Error()
+			return
+		}
+		errCode, errLog := app.reduceToken(nodeID, fee, tokenLedgerRef{
+			Method:    method,
+			Reason:    tokenLedgerReasonFee,
+			RequestID: requestIDParam.RequestID,
+		})
+		if errCode != code.OK {
+			fee = 0
+			if success {
+				result.Code = errCode
+				result.Log = errLog
+			}
+		}
+	}
+	result.Events = append(result.Events, types.Event{
+		Type: "did.fee",
+		Attributes: []cmn.KVPair{
+			{Key: []byte("node_id"), Value: []byte(nodeID)},
+			{Key: []byte("method"), Value: []byte(method)},
+			{Key: []byte("amount"), Value: []byte(formatTokenAmount(fee, app.getTokenDecimals(false)))},
+		},
+	})
+}
+
+func (app *ABCIApplication) setFeePolicy(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("SetFeePolicy, Parameter: %s", param)
+	var funcParam SetFeePolicyParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if !IsMethod[funcParam.Method] {
+		return app.ReturnDeliverTxLog(code.UnknownMethod, "Unknown method name", "")
+	}
+	if !isFeeChargingMode[funcParam.Mode] {
+		return app.ReturnDeliverTxLog(code.InvalidFeePolicy, "Invalid fee charging mode", "")
+	}
+	failureFee, err := parseTokenAmount(funcParam.FailureFee, app.getTokenDecimals(false))
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.InvalidTokenAmount, err.Error(), "")
+	}
+	if failureFee < 0 {
+		return app.ReturnDeliverTxLog(code.InvalidFeePolicy, "Failure fee must be greater than or equal to zero", "")
+	}
+	if failureFee > 0 && funcParam.Mode != feeChargingModeReducedOnFailure {
+		return app.ReturnDeliverTxLog(code.InvalidFeePolicy, "Failure fee can only be set with reduced_on_failure mode", "")
+	}
+	var policy data.FeePolicy
+	policy.Mode = funcParam.Mode
+	policy.FailureFee = failureFee
+	value, err := utils.ProtoDeterministicMarshal(&policy)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set([]byte(feePolicyKeyPrefix+keySeparator+funcParam.Method), value)
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+func (app *ABCIApplication) getFeePolicyQuery(param string) types.ResponseQuery {
+	app.logger.Infof("GetFeePolicy, Parameter: %s", param)
+	var funcParam GetFeePolicyParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	policy := app.getFeePolicy(funcParam.Method, true)
+	var result GetFeePolicyResult
+	result.Method = funcParam.Method
+	result.Mode = policy.Mode
+	result.FailureFee = formatTokenAmount(policy.FailureFee, app.getTokenDecimals(true))
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(value, "success", app.state.Height)
+}
@@ -0,0 +1,380 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/abci/utils"
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+	protoTm "github.com/ndidplatform/smart-contract/v4/protos/tendermint"
+)
+
+// Status of NDID proposal
+const (
+	ndidProposalStatusPending  = "pending"
+	ndidProposalStatusExecuted = "executed"
+	ndidProposalStatusExpired  = "expired"
+)
+
+// NDID methods which cannot be proposed since they are only used in init state
+var isNotProposableNDIDMethod = map[string]bool{
+	"InitNDID":    true,
+	"SetInitData": true,
+	"EndInit":     true,
+}
+
+func (app *ABCIApplication) getGovernanceFromStateDB(committedState bool) *data.Governance {
+	var governance data.Governance
+	governanceValue, _ := app.state.Get(governanceKeyBytes, committedState)
+	if governanceValue == nil {
+		return &governance
+	}
+	err := proto.Unmarshal(governanceValue, &governance)
+	if err != nil {
+		return &data.Governance{}
+	}
+	return &governance
+}
+
+// isGovernanceEnabled returns true when NDID methods require M-of-N approvals
+// from governance keys instead of being called directly by NDID node
+func (app *ABCIApplication) isGovernanceEnabled(committedState bool) bool {
+	return app.getGovernanceFromStateDB(committedState).Threshold > 0
+}
+
+func (app *ABCIApplication) setGovernance(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("SetGovernance, Parameter: %s", param)
+	var funcParam SetGovernanceParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	// Threshold 0 disables governance
+	if funcParam.Threshold < 0 || int(funcParam.Threshold) > len(funcParam.Keys) {
+		return app.ReturnDeliverTxLog(code.InvalidGovernanceThreshold, "Threshold must be between 0 and number of governance keys", "")
+	}
+	if funcParam.Threshold > 0 && funcParam.ProposalTimeoutBlock <= 0 {
+		return app.ReturnDeliverTxLog(code.TimeOutBlockIsMustGreaterThanZero, "Proposal time out block is must greater than 0", "")
+	}
+	var governance data.Governance
+	keyIDs := make(map[string]bool)
+	for _, key := range funcParam.Keys {
+		if key.KeyID == "" || keyIDs[key.KeyID] {
+			return app.ReturnDeliverTxLog(code.DuplicateGovernanceKeyID, "Governance key ID is empty or duplicate", "")
+		}
+		keyIDs[key.KeyID] = true
+		checkCode, log := checkPubKey(key.PublicKey)
+		if checkCode != code.OK {
+			return app.ReturnDeliverTxLog(checkCode, log, "")
+		}
+		var governanceKey data.GovernanceKey
+		governanceKey.KeyId = key.KeyID
+		governanceKey.PublicKey = key.PublicKey
+		governanceKey.PublicKeyAlgorithm = getPublicKeyAlgorithm(key.PublicKey)
+		governance.Keys = append(governance.Keys, &governanceKey)
+	}
+	governance.Threshold = funcParam.Threshold
+	governance.ProposalTimeoutBlock = funcParam.ProposalTimeoutBlock
+	governanceByte, err := utils.ProtoDeterministicMarshal(&governance)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set(governanceKeyBytes, governanceByte)
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+func ndidProposalKey(proposalID string) []byte {
+	return []byte(ndidProposalKeyPrefix + keySeparator + proposalID)
+}
+
+func ndidProposalDeadlineKey(expireBlock int64, proposalID string) []byte {
+	return []byte(proposalDeadlineKeyPrefix + keySeparator + fmt.Sprintf("%020d", expireBlock) + keySeparator + proposalID)
+}
+
+func (app *ABCIApplication) getNDIDProposalFromStateDB(proposalID string, committedState bool) (*data.NDIDProposal, error) {
+	proposalValue, _ := app.state.Get(ndidProposalKey(proposalID), committedState)
+	if proposalValue == nil {
+		return nil, nil
+	}
+	var proposal data.NDIDProposal
+	err := proto.Unmarshal(proposalValue, &proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
+
+func (app *ABCIApplication) setNDIDProposal(proposal *data.NDIDProposal) error {
+	proposalByte, err := utils.ProtoDeterministicMarshal(proposal)
+	if err != nil {
+		return err
+	}
+	app.state.Set(ndidProposalKey(proposal.ProposalId), proposalByte)
+	return nil
+}
+
+func (app *ABCIApplication) createNDIDProposal(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("CreateNDIDProposal, Parameter: %s", param)
+	var funcParam CreateNDIDProposalParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	governance := app.getGovernanceFromStateDB(false)
+	if governance.Threshold <= 0 {
+		return app.ReturnDeliverTxLog(code.GovernanceIsNotEnabled, "Governance is not enabled", "")
+	}
+	if !isNDIDMethod[funcParam.Method] || isNotProposableNDIDMethod[funcParam.Method] {
+		return app.ReturnDeliverTxLog(code.MethodCannotBeProposed, "Method cannot be proposed", "")
+	}
+	if funcParam.Method == "RegisterNode" {
+		checkCode, log := checkNodePubKeys(funcParam.Params)
+		if checkCode != code.OK {
+			return app.ReturnDeliverTxLog(checkCode, log, "")
+		}
+	}
+	existingProposal, err := app.getNDIDProposalFromStateDB(funcParam.ProposalID, false)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if existingProposal != nil {
+		return app.ReturnDeliverTxLog(code.NDIDProposalIDIsAlreadyExisted, "NDID proposal ID is already existed", "")
+	}
+	var proposal data.NDIDProposal
+	proposal.ProposalId = funcParam.ProposalID
+	proposal.Method = funcParam.Method
+	proposal.Params = funcParam.Params
+	proposal.Approvals = make([]string, 0)
+	proposal.CreationBlockHeight = app.state.CurrentBlockHeight
+	proposal.DeadlineBlock = app.state.CurrentBlockHeight + governance.ProposalTimeoutBlock
+	proposal.Status = ndidProposalStatusPending
+	err = app.setNDIDProposal(&proposal)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	// Proposal can be approved until (including) deadline block
+	app.state.Set(ndidProposalDeadlineKey(proposal.DeadlineBlock+1, proposal.ProposalId), []byte{})
+	var attributes []cmn.KVPair
+	var attribute cmn.KVPair
+	attribute.Key = []byte("proposal_id")
+	attribute.Value = []byte(proposal.ProposalId)
+	attributes = append(attributes, attribute)
+	attribute.Key = []byte("deadline_block")
+	attribute.Value = []byte(strconv.FormatInt(proposal.DeadlineBlock, 10))
+	attributes = append(attributes, attribute)
+	return app.ReturnDeliverTxLogWithAttributes(code.OK, "success", attributes)
+}
+
+// approveNDIDProposal adds approval of a governance key to proposal.
+// Governance key signs proposal deadline block, method, params, proposal ID and
+// chain ID (same as Tx signature with PSS_SHA256 scheme where deadline block
+// takes place of valid until block and "<proposal ID>|<chain ID>" takes place
+// of nonce) so approval cannot be replayed on another chain or proposal.
+// Proposed method is executed in the same Tx when approvals reach threshold
+// if it passes the same checks as a Tx of the method.
+func (app *ABCIApplication) approveNDIDProposal(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("ApproveNDIDProposal, Parameter: %s", param)
+	var funcParam ApproveNDIDProposalParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	proposal, err := app.getNDIDProposalFromStateDB(funcParam.ProposalID, false)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if proposal == nil {
+		return app.ReturnDeliverTxLog(code.NDIDProposalNotFound, "NDID proposal not found", "")
+	}
+	if proposal.Status != ndidProposalStatusPending || proposal.DeadlineBlock < app.state.CurrentBlockHeight {
+		return app.ReturnDeliverTxLog(code.NDIDProposalIsNotPending, "NDID proposal is not pending", "")
+	}
+	governance := app.getGovernanceFromStateDB(false)
+	if governance.Threshold <= 0 {
+		return app.ReturnDeliverTxLog(code.GovernanceIsNotEnabled, "Governance is not enabled", "")
+	}
+	governanceKeys := make(map[string]*data.GovernanceKey)
+	for _, key := range governance.Keys {
+		governanceKeys[key.KeyId] = key
+	}
+	governanceKey, ok := governanceKeys[funcParam.KeyID]
+	if !ok {
+		return app.ReturnDeliverTxLog(code.GovernanceKeyNotFound, "Governance key not found", "")
+	}
+	for _, approval := range proposal.Approvals {
+		if approval == funcParam.KeyID {
+			return app.ReturnDeliverTxLog(code.DuplicateNDIDProposalApproval, "Duplicate NDID proposal approval", "")
+		}
+	}
+	signedNonce := []byte(proposal.ProposalId + keySeparator + app.CurrentChain)
+	verifyResult, err := verifySignature(proposal.Params, signedNonce, funcParam.Signature, governanceKey.PublicKey, governanceKey.PublicKeyAlgorithm, proposal.Method, protoTm.SignatureScheme_PSS_SHA256, proposal.DeadlineBlock)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.VerifySignatureError, err.Error(), "")
+	}
+	if verifyResult == false {
+		return app.ReturnDeliverTxLog(code.VerifySignatureError, "Invalid approval signature", "")
+	}
+	proposal.Approvals = append(proposal.Approvals, funcParam.KeyID)
+
+	// Approvals of keys which have been removed from governance do not count
+	var approvalCount int32
+	for _, approval := range proposal.Approvals {
+		if _, ok := governanceKeys[approval]; ok {
+			approvalCount++
+		}
+	}
+
+	var attributes []cmn.KVPair
+	var attribute cmn.KVPair
+	attribute.Key = []byte("proposal_id")
+	attribute.Value = []byte(proposal.ProposalId)
+	attributes = append(attributes, attribute)
+
+	retCode := code.OK
+	retLog := "success"
+	if approvalCount >= governance.Threshold {
+		app.logger.Infof("Execute NDID proposal %s: %s", proposal.ProposalId, proposal.Method)
+		checkResult := app.checkTxRouter(proposal.Method, proposal.Params, nil, nil, nodeID, false, true)
+		if checkResult.Code == code.OK {
+			result := app.callDeliverTx(proposal.Method, proposal.Params, nodeID)
+			retCode = result.Code
+			retLog = result.Log
+		} else {
+			retCode = checkResult.Code
+			retLog = checkResult.Log
+		}
+		proposal.Status = ndidProposalStatusExecuted
+		proposal.ResultCode = retCode
+		proposal.ResultLog = retLog
+		app.state.Delete(ndidProposalDeadlineKey(proposal.DeadlineBlock+1, proposal.ProposalId))
+	}
+	err = app.setNDIDProposal(proposal)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	attribute.Key = []byte("proposal_status")
+	attribute.Value = []byte(proposal.Status)
+	attributes = append(attributes, attribute)
+	return app.ReturnDeliverTxLogWithAttributes(retCode, retLog, attributes)
+}
+
+// expireNDIDProposals marks pending proposals which deadline block
+// has passed as expired
+func (app *ABCIApplication) expireNDIDProposals() []types.Event {
+	start := []byte(proposalDeadlineKeyPrefix + keySeparator)
+	end := ndidProposalDeadlineKey(app.state.CurrentBlockHeight+1, "")
+	var expiredKeys [][]byte
+	app.state.IterateCommitted(start, end, func(key, value []byte) bool {
+		expiredKeys = append(expiredKeys, append([]byte{}, key...))
+		return true
+	})
+
+	events := make([]types.Event, 0)
+	for _, expiredKey := range expiredKeys {
+		app.state.Delete(expiredKey)
+		proposalID := strings.SplitN(string(expiredKey), keySeparator, 3)[2]
+		proposal, err := app.getNDIDProposalFromStateDB(proposalID, false)
+		if err != nil {
+			app.logger.Errorf("Expire NDID proposal %s: %s", proposalID, err.Error())
+			continue
+		}
+		if proposal == nil || proposal.Status != ndidProposalStatusPending {
+			continue
+		}
+		proposal.Status = ndidProposalStatusExpired
+		err = app.setNDIDProposal(proposal)
+		if err != nil {
+			app.logger.Errorf("Expire NDID proposal %s: %s", proposalID, err.Error())
+			continue
+		}
+		app.logger.Infof("NDID proposal expired: %s", proposalID)
+		events = append(events, types.Event{
+			Type: "did.ndid_proposal_expired",
+			Attributes: []cmn.KVPair{
+				{Key: []byte("proposal_id"), Value: []byte(proposalID)},
+			},
+		})
+	}
+	return events
+}
+
+func (app *ABCIApplication) getGovernance(param string) types.ResponseQuery {
+	app.logger.Infof("GetGovernance, Parameter: %s", param)
+	governance := app.getGovernanceFromStateDB(true)
+	var result GetGovernanceResult
+	result.Keys = make([]GovernanceKeyDetail, 0)
+	for _, key := range governance.Keys {
+		var keyDetail GovernanceKeyDetail
+		keyDetail.KeyID = key.KeyId
+		keyDetail.PublicKey = key.PublicKey
+		keyDetail.PublicKeyAlgorithm = key.PublicKeyAlgorithm
+		result.Keys = append(result.Keys, keyDetail)
+	}
+	result.Threshold = governance.Threshold
+	result.ProposalTimeoutBlock = governance.ProposalTimeoutBlock
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(returnValue, "success", app.state.Height)
+}
+
+func (app *ABCIApplication) getNDIDProposal(param string) types.ResponseQuery {
+	app.logger.Infof("GetNDIDProposal, Parameter: %s", param)
+	var funcParam GetNDIDProposalParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	proposal, err := app.getNDIDProposalFromStateDB(funcParam.ProposalID, true)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	if proposal == nil {
+		return app.ReturnQuery([]byte("{}"), "not found", app.state.Height)
+	}
+	var result GetNDIDProposalResult
+	result.ProposalID = proposal.ProposalId
+	result.Method = proposal.Method
+	result.Params = proposal.Params
+	result.Approvals = append(make([]string, 0), proposal.Approvals...)
+	result.CreationBlockHeight = proposal.CreationBlockHeight
+	result.DeadlineBlock = proposal.DeadlineBlock
+	result.Status = proposal.Status
+	result.ResultCode = proposal.ResultCode
+	result.ResultLog = proposal.ResultLog
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(returnValue, "success", app.state.Height)
+}
@@ -24,7 +24,10 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/tendermint/tendermint/abci/types"
@@ -53,7 +56,7 @@ func (app *ABCIApplication) AddAccessor(param string, nodeID string) types.Respo
 	}
 	refGroupCode := ""
 	if funcParam.ReferenceGroupCode != "" {
-		refGroupCode = funcParam.ReferenceGroupCode
+		refGroupCode = app.resolveMergedRefGroupCode(funcParam.ReferenceGroupCode, false)
 	} else {
 		identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + funcParam.IdentityNamespace + keySeparator + funcParam.IdentityIdentifierHash
 		refGroupCodeFromDB, _ := app.state.Get([]byte(identityToRefCodeKey), false)
@@ -102,6 +105,7 @@ func (app *ABCIApplication) AddAccessor(param string, nodeID string) types.Respo
 	accessor.AccessorId = funcParam.AccessorID
 	accessor.AccessorType = funcParam.AccessorType
 	accessor.AccessorPublicKey = funcParam.AccessorPublicKey
+	accessor.AccessorPublicKeyAlgorithm = getPublicKeyAlgorithm(funcParam.AccessorPublicKey)
 	accessor.Active = true
 	accessor.Owner = nodeID
 	for _, idp := range refGroup.Idps {
@@ -166,6 +170,7 @@ func (app *ABCIApplication) registerIdentity(param string, nodeID string) types.
 	if user.ReferenceGroupCode == "" {
 		return app.ReturnDeliverTxLog(code.RefGroupCodeCannotBeEmpty, "Please input reference group code", "")
 	}
+	user.ReferenceGroupCode = app.resolveMergedRefGroupCode(user.ReferenceGroupCode, false)
 	// Check accessor
 	if user.AccessorID == "" {
 		return app.ReturnDeliverTxLog(code.AccessorIDCannotBeEmpty, "Please input accessor ID", "")
@@ -230,7 +235,13 @@ func (app *ABCIApplication) registerIdentity(param string, nodeID string) types.
 			}
 		}
 	}
-	if mode3 && minIdp > 0 {
+	// Without consent request, registration is pending until it is cleared with
+	// ClearRegisterIdentityTimeout and is reverted after time out block
+	pending := false
+	timeOutBlock := app.getTimeOutBlockRegisterIdentityFromStateDB(false)
+	if mode3 && minIdp > 0 && user.RequestID == "" && timeOutBlock > 0 {
+		pending = true
+	} else if mode3 && minIdp > 0 {
 		checkRequestResult := app.checkRequest(user.RequestID, "RegisterIdentity", minIdp)
 		if checkRequestResult.Code != code.OK {
 			return checkRequestResult
@@ -244,6 +255,7 @@ func (app *ABCIApplication) registerIdentity(param string, nodeID string) types.
 	}
 	// Check number of Identifier in new list and old list in stateDB
 	var namespaceCount = map[string]int{}
+	var namespaceActiveCount = map[string]int{}
 	var checkDuplicateNamespaceAndHash = map[string]int{}
 	validNamespace := app.GetNamespaceMap(false)
 	for _, identity := range user.NewIdentityList {
@@ -260,6 +272,7 @@ func (app *ABCIApplication) registerIdentity(param string, nodeID string) types.
 			return app.ReturnDeliverTxLog(code.InvalidNamespace, "Namespace is invalid", "")
 		}
 		namespaceCount[identity.IdentityNamespace] = namespaceCount[identity.IdentityNamespace] + 1
+		namespaceActiveCount[identity.IdentityNamespace] = namespaceActiveCount[identity.IdentityNamespace] + 1
 		checkDuplicateNamespaceAndHash[identity.IdentityNamespace+identity.IdentityIdentifierHash] = checkDuplicateNamespaceAndHash[identity.IdentityNamespace+identity.IdentityIdentifierHash] + 1
 	}
 	// Check duplicate count
@@ -270,6 +283,9 @@ func (app *ABCIApplication) registerIdentity(param string, nodeID string) types.
 	}
 	for _, identity := range refGroup.Identities {
 		namespaceCount[identity.Namespace] = namespaceCount[identity.Namespace] + 1
+		if !identity.Inactive {
+			namespaceActiveCount[identity.Namespace] = namespaceActiveCount[identity.Namespace] + 1
+		}
 	}
 	allowedIdentifierCount := app.GetNamespaceAllowedIdentifierCountMap(false)
 	for namespace, count := range namespaceCount {
@@ -277,10 +293,17 @@ func (app *ABCIApplication) registerIdentity(param string, nodeID string) types.
 			return app.ReturnDeliverTxLog(code.IdentifierCountIsGreaterThanAllowedIdentifierCount, "Identifier count is greater than allowed identifier count", "")
 		}
 	}
+	allowedActiveIdentifierCount := app.GetNamespaceAllowedActiveIdentifierCountMap(false)
+	for namespace, count := range namespaceActiveCount {
+		if count > allowedActiveIdentifierCount[namespace] && allowedActiveIdentifierCount[namespace] > 0 {
+			return app.ReturnDeliverTxLog(code.ActiveIdentifierCountIsGreaterThanAllowedCount, "Active identifier count is greater than allowed active identifier count", "")
+		}
+	}
 	var accessor data.Accessor
 	accessor.AccessorId = user.AccessorID
 	accessor.AccessorType = user.AccessorType
 	accessor.AccessorPublicKey = user.AccessorPublicKey
+	accessor.AccessorPublicKeyAlgorithm = getPublicKeyAlgorithm(user.AccessorPublicKey)
 	accessor.Active = true
 	accessor.Owner = nodeID
 	var idp data.IdPInRefGroup
@@ -289,6 +312,47 @@ func (app *ABCIApplication) registerIdentity(param string, nodeID string) types.
 	idp.Accessors = append(idp.Accessors, &accessor)
 	idp.Ial = user.Ial
 	idp.Active = true
+	accessorToRefCodeKey := accessorToRefCodeKeyPrefix + keySeparator + user.AccessorID
+	if pending {
+		// Pending registration is kept out of reference group until it is
+		// cleared. Identities and accessor are only reserved.
+		if app.state.Has([]byte(accessorToRefCodeKey), false) {
+			return app.ReturnDeliverTxLog(code.DuplicateAccessorID, "Duplicate accessor ID", "")
+		}
+		var pendingRegisterIdentity data.PendingRegisterIdentity
+		pendingRegisterIdentity.NodeId = nodeID
+		pendingRegisterIdentity.AccessorId = user.AccessorID
+		pendingRegisterIdentity.TimeoutBlock = app.state.CurrentBlockHeight + timeOutBlock
+		pendingRegisterIdentity.ReferenceGroupCode = user.ReferenceGroupCode
+		pendingRegisterIdentity.Idp = &idp
+		for _, identity := range user.NewIdentityList {
+			var newIdentity data.IdentityInRefGroup
+			newIdentity.Namespace = identity.IdentityNamespace
+			newIdentity.IdentifierHash = identity.IdentityIdentifierHash
+			pendingRegisterIdentity.Identities = append(pendingRegisterIdentity.Identities, &newIdentity)
+		}
+		pendingRegisterIdentityValue, err := utils.ProtoDeterministicMarshal(&pendingRegisterIdentity)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+		}
+		pendingRegisterIdentityKey := pendingIdentityKeyPrefix + keySeparator + user.AccessorID
+		app.state.Set([]byte(pendingRegisterIdentityKey), []byte(pendingRegisterIdentityValue))
+		app.state.Set(registerIdentityTimeoutKey(pendingRegisterIdentity.TimeoutBlock, user.AccessorID), []byte{})
+		for _, identity := range user.NewIdentityList {
+			identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + identity.IdentityNamespace + keySeparator + identity.IdentityIdentifierHash
+			app.state.Set([]byte(identityToRefCodeKey), []byte(user.ReferenceGroupCode))
+		}
+		app.state.Set([]byte(accessorToRefCodeKey), []byte(user.ReferenceGroupCode))
+		var attributes []cmn.KVPair
+		var attribute cmn.KVPair
+		attribute.Key = []byte("reference_group_code")
+		attribute.Value = []byte(user.ReferenceGroupCode)
+		attributes = append(attributes, attribute)
+		attribute.Key = []byte("register_identity_timeout_block")
+		attribute.Value = []byte(strconv.FormatInt(pendingRegisterIdentity.TimeoutBlock, 10))
+		attributes = append(attributes, attribute)
+		return app.ReturnDeliverTxLogWithAttributes(code.OK, "success", attributes)
+	}
 	for _, identity := range user.NewIdentityList {
 		var newIdentity data.IdentityInRefGroup
 		newIdentity.Namespace = identity.IdentityNamespace
@@ -305,6 +369,7 @@ func (app *ABCIApplication) registerIdentity(param string, nodeID string) types.
 				if accessor.AccessorId == funcParam.AccessorID {
 					refGroup.Idps[iIdp].Accessors[iAcc].AccessorType = user.AccessorType
 					refGroup.Idps[iIdp].Accessors[iAcc].AccessorPublicKey = user.AccessorPublicKey
+					refGroup.Idps[iIdp].Accessors[iAcc].AccessorPublicKeyAlgorithm = getPublicKeyAlgorithm(user.AccessorPublicKey)
 					refGroup.Idps[iIdp].Accessors[iAcc].Active = true
 					foundAccessorInThisGroup = true
 				}
@@ -331,7 +396,6 @@ func (app *ABCIApplication) registerIdentity(param string, nodeID string) types.
 		}
 	}
 
-	accessorToRefCodeKey := accessorToRefCodeKeyPrefix + keySeparator + user.AccessorID
 	accessorToRefCodeValue := user.ReferenceGroupCode
 	for _, identity := range user.NewIdentityList {
 		identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + identity.IdentityNamespace + keySeparator + identity.IdentityIdentifierHash
@@ -348,6 +412,179 @@ func (app *ABCIApplication) registerIdentity(param string, nodeID string) types.
 	return app.ReturnDeliverTxLogWithAttributes(code.OK, "success", attributes)
 }
 
+func (app *ABCIApplication) clearRegisterIdentityTimeout(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("ClearRegisterIdentityTimeout, Parameter: %s", param)
+	var funcParam ClearRegisterIdentityTimeoutParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	pendingRegisterIdentityKey := pendingIdentityKeyPrefix + keySeparator + funcParam.AccessorID
+	pendingRegisterIdentityValue, _ := app.state.Get([]byte(pendingRegisterIdentityKey), false)
+	if pendingRegisterIdentityValue == nil {
+		return app.ReturnDeliverTxLog(code.PendingRegisterIdentityNotFound, "Pending register identity not found", "")
+	}
+	var pendingRegisterIdentity data.PendingRegisterIdentity
+	err = proto.Unmarshal(pendingRegisterIdentityValue, &pendingRegisterIdentity)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if pendingRegisterIdentity.NodeId != nodeID {
+		return app.ReturnDeliverTxLog(code.PendingRegisterIdentityNotFound, "Pending register identity not found", "")
+	}
+	minIdp := 1
+	checkRequestResult := app.checkRequest(funcParam.RequestID, "RegisterIdentity", minIdp)
+	if checkRequestResult.Code != code.OK {
+		return checkRequestResult
+	}
+	// Add identities and IdP entry of pending registration to reference group
+	// (which may have been merged into another group while pending)
+	refGroupCode := app.resolveMergedRefGroupCode(pendingRegisterIdentity.ReferenceGroupCode, false)
+	refGroupKey := refGroupCodeKeyPrefix + keySeparator + refGroupCode
+	refGroupValue, _ := app.state.Get([]byte(refGroupKey), false)
+	var refGroup data.ReferenceGroup
+	if refGroupValue != nil {
+		err = proto.Unmarshal(refGroupValue, &refGroup)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+		}
+	}
+	refGroup.Identities = append(refGroup.Identities, pendingRegisterIdentity.Identities...)
+	retCode, retLog := app.checkIdentifierCount(&refGroup)
+	if retCode != code.OK {
+		return app.ReturnDeliverTxLog(retCode, retLog, "")
+	}
+	pendingIdp := pendingRegisterIdentity.Idp
+	foundThisNodeID := false
+	for iIdp, idp := range refGroup.Idps {
+		if idp.NodeId == nodeID {
+			refGroup.Idps[iIdp].Active = true
+			refGroup.Idps[iIdp].Mode = pendingIdp.Mode
+			refGroup.Idps[iIdp].Accessors = append(refGroup.Idps[iIdp].Accessors, pendingIdp.Accessors...)
+			foundThisNodeID = true
+			break
+		}
+	}
+	if !foundThisNodeID {
+		refGroup.Idps = append(refGroup.Idps, pendingIdp)
+	}
+	refGroupValue, err = utils.ProtoDeterministicMarshal(&refGroup)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	increaseRequestUseCountResult := app.increaseRequestUseCount(funcParam.RequestID)
+	if increaseRequestUseCountResult.Code != code.OK {
+		return increaseRequestUseCountResult
+	}
+	app.state.Delete([]byte(pendingRegisterIdentityKey))
+	app.state.Delete(registerIdentityTimeoutKey(pendingRegisterIdentity.TimeoutBlock, funcParam.AccessorID))
+	for _, identity := range pendingRegisterIdentity.Identities {
+		identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + identity.Namespace + keySeparator + identity.IdentifierHash
+		app.state.Set([]byte(identityToRefCodeKey), []byte(refGroupCode))
+	}
+	accessorToRefCodeKey := accessorToRefCodeKeyPrefix + keySeparator + funcParam.AccessorID
+	app.state.Set([]byte(accessorToRefCodeKey), []byte(refGroupCode))
+	app.state.Set([]byte(refGroupKey), []byte(refGroupValue))
+	var attributes []cmn.KVPair
+	var attribute cmn.KVPair
+	attribute.Key = []byte("reference_group_code")
+	attribute.Value = []byte(refGroupCode)
+	attributes = append(attributes, attribute)
+	return app.ReturnDeliverTxLogWithAttributes(code.OK, "success", attributes)
+}
+
+// registerIdentityTimeoutKey returns key in pending register identity time out index.
+// Block height is zero-padded so keys are sorted by height.
+func registerIdentityTimeoutKey(timeOutBlock int64, accessorID string) []byte {
+	return []byte(registerTimeoutKeyPrefix + keySeparator + fmt.Sprintf("%020d", timeOutBlock) + keySeparator + accessorID)
+}
+
+// timeOutPendingRegisterIdentities reverts pending identity registrations
+// which have not been cleared before their time out block
+func (app *ABCIApplication) timeOutPendingRegisterIdentities() []types.Event {
+	start := []byte(registerTimeoutKeyPrefix + keySeparator)
+	end := registerIdentityTimeoutKey(app.state.CurrentBlockHeight+1, "")
+	var expiredKeys [][]byte
+	app.state.IterateCommitted(start, end, func(key, value []byte) bool {
+		expiredKeys = append(expiredKeys, append([]byte{}, key...))
+		return true
+	})
+
+	events := make([]types.Event, 0)
+	for _, expiredKey := range expiredKeys {
+		app.state.Delete(expiredKey)
+		accessorID := strings.SplitN(string(expiredKey), keySeparator, 3)[2]
+		pendingRegisterIdentityKey := pendingIdentityKeyPrefix + keySeparator + accessorID
+		value, _ := app.state.Get([]byte(pendingRegisterIdentityKey), false)
+		if value == nil {
+			continue
+		}
+		app.state.Delete([]byte(pendingRegisterIdentityKey))
+		var pendingRegisterIdentity data.PendingRegisterIdentity
+		err := proto.Unmarshal(value, &pendingRegisterIdentity)
+		if err != nil {
+			app.logger.Errorf("Revert register identity %s: %s", accessorID, err.Error())
+			continue
+		}
+		refGroupCode := app.revertRegisterIdentity(&pendingRegisterIdentity)
+		app.logger.Infof("Register identity timed out: %s", accessorID)
+		events = append(events, types.Event{
+			Type: "did.register_identity_timed_out",
+			Attributes: []cmn.KVPair{
+				{Key: []byte("reference_group_code"), Value: []byte(refGroupCode)},
+				{Key: []byte("node_id"), Value: []byte(pendingRegisterIdentity.NodeId)},
+				{Key: []byte("accessor_id"), Value: []byte(accessorID)},
+			},
+		})
+	}
+	return events
+}
+
+// revertRegisterIdentity releases identities and accessor reserved by pending
+// registration. Reference group is not changed by pending registration.
+func (app *ABCIApplication) revertRegisterIdentity(pendingRegisterIdentity *data.PendingRegisterIdentity) string {
+	refGroupCode := pendingRegisterIdentity.ReferenceGroupCode
+	for _, identity := range pendingRegisterIdentity.Identities {
+		identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + identity.Namespace + keySeparator + identity.IdentifierHash
+		identityToRefCodeValue, _ := app.state.Get([]byte(identityToRefCodeKey), false)
+		if string(identityToRefCodeValue) == refGroupCode {
+			app.state.Delete([]byte(identityToRefCodeKey))
+		}
+	}
+	accessorToRefCodeKey := accessorToRefCodeKeyPrefix + keySeparator + pendingRegisterIdentity.AccessorId
+	accessorToRefCodeValue, _ := app.state.Get([]byte(accessorToRefCodeKey), false)
+	if string(accessorToRefCodeValue) == refGroupCode {
+		app.state.Delete([]byte(accessorToRefCodeKey))
+	}
+	return refGroupCode
+}
+
+// checkIdentifierCount checks number of identifiers and active identifiers
+// of each namespace in reference group against allowed count of namespace
+func (app *ABCIApplication) checkIdentifierCount(refGroup *data.ReferenceGroup) (uint32, string) {
+	var namespaceCount = map[string]int{}
+	var namespaceActiveCount = map[string]int{}
+	for _, identity := range refGroup.Identities {
+		namespaceCount[identity.Namespace] = namespaceCount[identity.Namespace] + 1
+		if !identity.Inactive {
+			namespaceActiveCount[identity.Namespace] = namespaceActiveCount[identity.Namespace] + 1
+		}
+	}
+	allowedIdentifierCount := app.GetNamespaceAllowedIdentifierCountMap(false)
+	for namespace, count := range namespaceCount {
+		if count > allowedIdentifierCount[namespace] && allowedIdentifierCount[namespace] > 0 {
+			return code.IdentifierCountIsGreaterThanAllowedIdentifierCount, "Identifier count is greater than allowed identifier count"
+		}
+	}
+	allowedActiveIdentifierCount := app.GetNamespaceAllowedActiveIdentifierCountMap(false)
+	for namespace, count := range namespaceActiveCount {
+		if count > allowedActiveIdentifierCount[namespace] && allowedActiveIdentifierCount[namespace] > 0 {
+			return code.ActiveIdentifierCountIsGreaterThanAllowedCount, "Active identifier count is greater than allowed active identifier count"
+		}
+	}
+	return code.OK, ""
+}
+
 func (app *ABCIApplication) checkRequest(requestID string, purpose string, minIdp int) types.ResponseDeliverTx {
 	requestKey := requestKeyPrefix + keySeparator + requestID
 	requestValue, _ := app.state.GetVersioned([]byte(requestKey), app.state.Height, true)
@@ -431,49 +668,18 @@ func (app *ABCIApplication) createIdpResponse(param string, nodeID string) types
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
 	// Check duplicate before add
-	chkDup := false
-	for _, oldResponse := range request.ResponseList {
-		if &response == oldResponse {
-			chkDup = true
-			break
-		}
-	}
-	// Check AAL
-	if request.MinAal > response.Aal {
-		return app.ReturnDeliverTxLog(code.AALError, "Response's AAL is less than min AAL", "")
-	}
-	// Check IAL
-	if request.MinIal > response.Ial {
-		return app.ReturnDeliverTxLog(code.IALError, "Response's IAL is less than min IAL", "")
-	}
-	// Check AAL, IAL with MaxIalAal
-	nodeDetailKey := nodeIDKeyPrefix + keySeparator + nodeID
-	nodeDetailValue, _ := app.state.Get([]byte(nodeDetailKey), false)
-	if nodeDetailValue == nil {
-		return app.ReturnDeliverTxLog(code.NodeIDNotFound, "Node ID not found", "")
-	}
-	var nodeDetail data.NodeDetail
-	err = proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
-	if err != nil {
-		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
-	}
-	if response.Aal > nodeDetail.MaxAal {
-		return app.ReturnDeliverTxLog(code.AALError, "Response's AAL is greater than max AAL", "")
-	}
-	if response.Ial > nodeDetail.MaxIal {
-		return app.ReturnDeliverTxLog(code.IALError, "Response's IAL is greater than max IAL", "")
+	chkDup := getIdpResponseIndex(&request, nodeID) >= 0
+	retCode, retLog := app.checkIdpResponseAalIal(&request, &response, nodeID)
+	if retCode != code.OK {
+		return app.ReturnDeliverTxLog(retCode, retLog, "")
 	}
 	// Check min_idp
 	if int64(len(request.ResponseList)) >= request.MinIdp {
 		return app.ReturnDeliverTxLog(code.RequestIsCompleted, "Can't response a request that's complete response", "")
 	}
-	// Check IsClosed
-	if request.Closed {
-		return app.ReturnDeliverTxLog(code.RequestIsClosed, "Can't response a request that's closed", "")
-	}
-	// Check IsTimedOut
-	if request.TimedOut {
-		return app.ReturnDeliverTxLog(code.RequestIsTimedOut, "Can't response a request that's timed out", "")
+	retCode, retLog = checkRequestIsOpen(&request)
+	if retCode != code.OK {
+		return app.ReturnDeliverTxLog(retCode, retLog, "")
 	}
 	// Check nodeID is exist in idp_id_list
 	exist := false
@@ -490,6 +696,87 @@ func (app *ABCIApplication) createIdpResponse(param string, nodeID string) types
 		return app.ReturnDeliverTxLog(code.DuplicateResponse, "Duplicate Response", "")
 	}
 	request.ResponseList = append(request.ResponseList, &response)
+	app.addResponseHistory(&request, "create", &response)
+	value, err = utils.ProtoDeterministicMarshal(&request)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.state.SetVersioned([]byte(key), []byte(value))
+	return app.ReturnDeliverTxLog(code.OK, "success", funcParam.RequestID)
+}
+
+func (app *ABCIApplication) updateIdpResponse(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("UpdateIdpResponse, Parameter: %s", param)
+	var funcParam UpdateIdpResponseParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	key := requestKeyPrefix + keySeparator + funcParam.RequestID
+	var response data.Response
+	response.Ial = funcParam.Ial
+	response.Aal = funcParam.Aal
+	response.Status = funcParam.Status
+	response.Signature = funcParam.Signature
+	response.IdpId = nodeID
+	value, _ := app.state.GetVersioned([]byte(key), 0, false)
+	if value == nil {
+		return app.ReturnDeliverTxLog(code.RequestIDNotFound, "Request ID not found", "")
+	}
+	var request data.Request
+	err = proto.Unmarshal([]byte(value), &request)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	retCode, retLog := app.checkIdpResponseAalIal(&request, &response, nodeID)
+	if retCode != code.OK {
+		return app.ReturnDeliverTxLog(retCode, retLog, "")
+	}
+	retCode, retLog = checkRequestIsOpen(&request)
+	if retCode != code.OK {
+		return app.ReturnDeliverTxLog(retCode, retLog, "")
+	}
+	index := getIdpResponseIndex(&request, nodeID)
+	if index < 0 {
+		return app.ReturnDeliverTxLog(code.IdpResponseNotFound, "IdP response not found", "")
+	}
+	request.ResponseList[index] = &response
+	app.addResponseHistory(&request, "update", &response)
+	value, err = utils.ProtoDeterministicMarshal(&request)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.state.SetVersioned([]byte(key), []byte(value))
+	return app.ReturnDeliverTxLog(code.OK, "success", funcParam.RequestID)
+}
+
+func (app *ABCIApplication) withdrawIdpResponse(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("WithdrawIdpResponse, Parameter: %s", param)
+	var funcParam WithdrawIdpResponseParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	key := requestKeyPrefix + keySeparator + funcParam.RequestID
+	value, _ := app.state.GetVersioned([]byte(key), 0, false)
+	if value == nil {
+		return app.ReturnDeliverTxLog(code.RequestIDNotFound, "Request ID not found", "")
+	}
+	var request data.Request
+	err = proto.Unmarshal([]byte(value), &request)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	retCode, retLog := checkRequestIsOpen(&request)
+	if retCode != code.OK {
+		return app.ReturnDeliverTxLog(retCode, retLog, "")
+	}
+	index := getIdpResponseIndex(&request, nodeID)
+	if index < 0 {
+		return app.ReturnDeliverTxLog(code.IdpResponseNotFound, "IdP response not found", "")
+	}
+	request.ResponseList = append(request.ResponseList[:index], request.ResponseList[index+1:]...)
+	app.addResponseHistory(&request, "withdraw", &data.Response{IdpId: nodeID})
 	value, err = utils.ProtoDeterministicMarshal(&request)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
@@ -498,6 +785,66 @@ func (app *ABCIApplication) createIdpResponse(param string, nodeID string) types
 	return app.ReturnDeliverTxLog(code.OK, "success", funcParam.RequestID)
 }
 
+// getIdpResponseIndex returns index of response of IdP in response list of request, -1 if not found
+func getIdpResponseIndex(request *data.Request, idpID string) int {
+	for index, response := range request.ResponseList {
+		if response.IdpId == idpID {
+			return index
+		}
+	}
+	return -1
+}
+
+// checkIdpResponseAalIal checks AAL and IAL of response against request and max AAL and IAL of IdP
+func (app *ABCIApplication) checkIdpResponseAalIal(request *data.Request, response *data.Response, nodeID string) (uint32, string) {
+	// Check AAL
+	if request.MinAal > response.Aal {
+		return code.AALError, "Response's AAL is less than min AAL"
+	}
+	// Check IAL
+	if request.MinIal > response.Ial {
+		return code.IALError, "Response's IAL is less than min IAL"
+	}
+	// Check AAL, IAL with MaxIalAal
+	nodeDetailKey := nodeIDKeyPrefix + keySeparator + nodeID
+	nodeDetailValue, _ := app.state.Get([]byte(nodeDetailKey), false)
+	if nodeDetailValue == nil {
+		return code.NodeIDNotFound, "Node ID not found"
+	}
+	var nodeDetail data.NodeDetail
+	err := proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
+	if err != nil {
+		return code.UnmarshalError, err.Error()
+	}
+	if response.Aal > nodeDetail.MaxAal {
+		return code.AALError, "Response's AAL is greater than max AAL"
+	}
+	if response.Ial > nodeDetail.MaxIal {
+		return code.IALError, "Response's IAL is greater than max IAL"
+	}
+	return code.OK, ""
+}
+
+func checkRequestIsOpen(request *data.Request) (uint32, string) {
+	// Check IsClosed
+	if request.Closed {
+		return code.RequestIsClosed, "Can't response a request that's closed"
+	}
+	// Check IsTimedOut
+	if request.TimedOut {
+		return code.RequestIsTimedOut, "Can't response a request that's timed out"
+	}
+	return code.OK, ""
+}
+
+func (app *ABCIApplication) addResponseHistory(request *data.Request, action string, response *data.Response) {
+	request.ResponseHistory = append(request.ResponseHistory, &data.ResponseHistory{
+		Action:      action,
+		BlockHeight: app.state.CurrentBlockHeight,
+		Response:    response,
+	})
+}
+
 func (app *ABCIApplication) updateIdentity(param string, nodeID string) types.ResponseDeliverTx {
 	app.logger.Infof("UpdateIdentity, Parameter: %s", param)
 	var funcParam UpdateIdentityParam
@@ -524,7 +871,7 @@ func (app *ABCIApplication) updateIdentity(param string, nodeID string) types.Re
 	}
 	refGroupCode := ""
 	if funcParam.ReferenceGroupCode != "" {
-		refGroupCode = funcParam.ReferenceGroupCode
+		refGroupCode = app.resolveMergedRefGroupCode(funcParam.ReferenceGroupCode, false)
 	} else {
 		identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + funcParam.IdentityNamespace + keySeparator + funcParam.IdentityIdentifierHash
 		refGroupCodeFromDB, _ := app.state.Get([]byte(identityToRefCodeKey), false)
@@ -597,7 +944,7 @@ func (app *ABCIApplication) revokeIdentityAssociation(param string, nodeID strin
 	}
 	refGroupCode := ""
 	if funcParam.ReferenceGroupCode != "" {
-		refGroupCode = funcParam.ReferenceGroupCode
+		refGroupCode = app.resolveMergedRefGroupCode(funcParam.ReferenceGroupCode, false)
 	} else {
 		identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + funcParam.IdentityNamespace + keySeparator + funcParam.IdentityIdentifierHash
 		refGroupCodeFromDB, _ := app.state.Get([]byte(identityToRefCodeKey), false)
@@ -811,7 +1158,7 @@ func (app *ABCIApplication) updateIdentityModeList(param string, nodeID string)
 	}
 	refGroupCode := ""
 	if funcParam.ReferenceGroupCode != "" {
-		refGroupCode = funcParam.ReferenceGroupCode
+		refGroupCode = app.resolveMergedRefGroupCode(funcParam.ReferenceGroupCode, false)
 	} else {
 		identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + funcParam.IdentityNamespace + keySeparator + funcParam.IdentityIdentifierHash
 		refGroupCodeFromDB, _ := app.state.Get([]byte(identityToRefCodeKey), false)
@@ -911,6 +1258,7 @@ func (app *ABCIApplication) addIdentity(param string, nodeID string) types.Respo
 	if user.ReferenceGroupCode == "" {
 		return app.ReturnDeliverTxLog(code.RefGroupCodeCannotBeEmpty, "Please input reference group code", "")
 	}
+	user.ReferenceGroupCode = app.resolveMergedRefGroupCode(user.ReferenceGroupCode, false)
 	refGroupKey := refGroupCodeKeyPrefix + keySeparator + user.ReferenceGroupCode
 	refGroupValue, _ := app.state.Get([]byte(refGroupKey), false)
 	var refGroup data.ReferenceGroup
@@ -941,6 +1289,7 @@ func (app *ABCIApplication) addIdentity(param string, nodeID string) types.Respo
 	}
 	// Check number of Identifier in new list and old list in stateDB
 	var namespaceCount = map[string]int{}
+	var namespaceActiveCount = map[string]int{}
 	var checkDuplicateNamespaceAndHash = map[string]int{}
 	validNamespace := app.GetNamespaceMap(false)
 	for _, identity := range user.NewIdentityList {
@@ -957,6 +1306,7 @@ func (app *ABCIApplication) addIdentity(param string, nodeID string) types.Respo
 			return app.ReturnDeliverTxLog(code.InvalidNamespace, "Namespace is invalid", "")
 		}
 		namespaceCount[identity.IdentityNamespace] = namespaceCount[identity.IdentityNamespace] + 1
+		namespaceActiveCount[identity.IdentityNamespace] = namespaceActiveCount[identity.IdentityNamespace] + 1
 		checkDuplicateNamespaceAndHash[identity.IdentityNamespace+identity.IdentityIdentifierHash] = checkDuplicateNamespaceAndHash[identity.IdentityNamespace+identity.IdentityIdentifierHash] + 1
 	}
 	// Check duplicate count
@@ -967,6 +1317,9 @@ func (app *ABCIApplication) addIdentity(param string, nodeID string) types.Respo
 	}
 	for _, identity := range refGroup.Identities {
 		namespaceCount[identity.Namespace] = namespaceCount[identity.Namespace] + 1
+		if !identity.Inactive {
+			namespaceActiveCount[identity.Namespace] = namespaceActiveCount[identity.Namespace] + 1
+		}
 	}
 	allowedIdentifierCount := app.GetNamespaceAllowedIdentifierCountMap(false)
 	for namespace, count := range namespaceCount {
@@ -974,6 +1327,12 @@ func (app *ABCIApplication) addIdentity(param string, nodeID string) types.Respo
 			return app.ReturnDeliverTxLog(code.IdentifierCountIsGreaterThanAllowedIdentifierCount, "Identifier count is greater than allowed identifier count", "")
 		}
 	}
+	allowedActiveIdentifierCount := app.GetNamespaceAllowedActiveIdentifierCountMap(false)
+	for namespace, count := range namespaceActiveCount {
+		if count > allowedActiveIdentifierCount[namespace] && allowedActiveIdentifierCount[namespace] > 0 {
+			return app.ReturnDeliverTxLog(code.ActiveIdentifierCountIsGreaterThanAllowedCount, "Active identifier count is greater than allowed active identifier count", "")
+		}
+	}
 	foundThisNodeID := false
 	mode3 := false
 	for _, idp := range refGroup.Idps {
@@ -1135,6 +1494,7 @@ func (app *ABCIApplication) revokeAndAddAccessor(param string, nodeID string) ty
 	accessor.AccessorId = funcParam.AccessorID
 	accessor.AccessorType = funcParam.AccessorType
 	accessor.AccessorPublicKey = funcParam.AccessorPublicKey
+	accessor.AccessorPublicKeyAlgorithm = getPublicKeyAlgorithm(funcParam.AccessorPublicKey)
 	accessor.Active = true
 	accessor.Owner = nodeID
 	for _, idp := range refGroup.Idps {
@@ -1166,6 +1526,320 @@ func (app *ABCIApplication) revokeAndAddAccessor(param string, nodeID string) ty
 	return app.ReturnDeliverTxLogWithAttributes(code.OK, "success", attributes)
 }
 
+func (app *ABCIApplication) mergeReferenceGroup(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("MergeReferenceGroup, Parameter: %s", param)
+	var funcParam MergeReferenceGroupParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if funcParam.ReferenceGroupCode != "" && funcParam.IdentityNamespace != "" && funcParam.IdentityIdentifierHash != "" {
+		return app.ReturnDeliverTxLog(code.GotRefGroupCodeAndIdentity, "Found reference group code and identity detail in parameter", "")
+	}
+	if funcParam.ReferenceGroupCodeToMerge != "" && funcParam.IdentityNamespaceToMerge != "" && funcParam.IdentityIdentifierHashToMerge != "" {
+		return app.ReturnDeliverTxLog(code.GotRefGroupCodeAndIdentity, "Found reference group code and identity detail in parameter", "")
+	}
+	if funcParam.IdentityNamespace != "" &&
+		funcParam.IdentityNamespace == funcParam.IdentityNamespaceToMerge &&
+		funcParam.IdentityIdentifierHash == funcParam.IdentityIdentifierHashToMerge {
+		return app.ReturnDeliverTxLog(code.CannotMergeSameReferenceGroup, "Cannot merge reference group with itself", "")
+	}
+	refGroupCode := app.getRefGroupCodeByCodeOrIdentity(funcParam.ReferenceGroupCode, funcParam.IdentityNamespace, funcParam.IdentityIdentifierHash, false)
+	refGroupCodeToMerge := app.getRefGroupCodeByCodeOrIdentity(funcParam.ReferenceGroupCodeToMerge, funcParam.IdentityNamespaceToMerge, funcParam.IdentityIdentifierHashToMerge, false)
+	if refGroupCode == "" || refGroupCodeToMerge == "" {
+		return app.ReturnDeliverTxLog(code.RefGroupNotFound, "Reference group not found", "")
+	}
+	if refGroupCode == refGroupCodeToMerge {
+		return app.ReturnDeliverTxLog(code.CannotMergeSameReferenceGroup, "Cannot merge reference group with itself", "")
+	}
+	refGroupKey := refGroupCodeKeyPrefix + keySeparator + refGroupCode
+	refGroupValue, _ := app.state.Get([]byte(refGroupKey), false)
+	if refGroupValue == nil {
+		return app.ReturnDeliverTxLog(code.RefGroupNotFound, "Reference group not found", "")
+	}
+	var refGroup data.ReferenceGroup
+	err = proto.Unmarshal(refGroupValue, &refGroup)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	refGroupToMergeKey := refGroupCodeKeyPrefix + keySeparator + refGroupCodeToMerge
+	refGroupToMergeValue, _ := app.state.Get([]byte(refGroupToMergeKey), false)
+	if refGroupToMergeValue == nil {
+		return app.ReturnDeliverTxLog(code.RefGroupNotFound, "Reference group not found", "")
+	}
+	var refGroupToMerge data.ReferenceGroup
+	err = proto.Unmarshal(refGroupToMergeValue, &refGroupToMerge)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+
+	// IdP must be associated with identity in both groups (NDID can merge any groups)
+	mode3 := false
+	if !app.checkNDID(param, nodeID, false) {
+		for _, group := range []*data.ReferenceGroup{&refGroup, &refGroupToMerge} {
+			foundThisNodeID := false
+			for _, idp := range group.Idps {
+				if idp.NodeId == nodeID && idp.Active {
+					foundThisNodeID = true
+					for _, mode := range idp.Mode {
+						if mode == 3 {
+							mode3 = true
+							break
+						}
+					}
+					break
+				}
+			}
+			if foundThisNodeID == false {
+				return app.ReturnDeliverTxLog(code.IdentityNotFoundInThisIdP, "Identity not found in this IdP", "")
+			}
+		}
+	}
+	if mode3 {
+		minIdp := 1
+		checkRequestResult := app.checkRequest(funcParam.RequestID, "MergeReferenceGroup", minIdp)
+		if checkRequestResult.Code != code.OK {
+			return checkRequestResult
+		}
+	}
+
+	// Union identities and check number of identifier in merged group
+	var namespaceCount = map[string]int{}
+	var namespaceActiveCount = map[string]int{}
+	var existingIdentity = map[string]bool{}
+	for _, identity := range refGroup.Identities {
+		existingIdentity[identity.Namespace+keySeparator+identity.IdentifierHash] = true
+	}
+	for _, identity := range refGroupToMerge.Identities {
+		if existingIdentity[identity.Namespace+keySeparator+identity.IdentifierHash] {
+			continue
+		}
+		existingIdentity[identity.Namespace+keySeparator+identity.IdentifierHash] = true
+		refGroup.Identities = append(refGroup.Identities, identity)
+	}
+	for _, identity := range refGroup.Identities {
+		namespaceCount[identity.Namespace] = namespaceCount[identity.Namespace] + 1
+		if !identity.Inactive {
+			namespaceActiveCount[identity.Namespace] = namespaceActiveCount[identity.Namespace] + 1
+		}
+	}
+	allowedIdentifierCount := app.GetNamespaceAllowedIdentifierCountMap(false)
+	for namespace, count := range namespaceCount {
+		if count > allowedIdentifierCount[namespace] && allowedIdentifierCount[namespace] > 0 {
+			return app.ReturnDeliverTxLog(code.IdentifierCountIsGreaterThanAllowedIdentifierCount, "Identifier count is greater than allowed identifier count", "")
+		}
+	}
+	allowedActiveIdentifierCount := app.GetNamespaceAllowedActiveIdentifierCountMap(false)
+	for namespace, count := range namespaceActiveCount {
+		if count > allowedActiveIdentifierCount[namespace] && allowedActiveIdentifierCount[namespace] > 0 {
+			return app.ReturnDeliverTxLog(code.ActiveIdentifierCountIsGreaterThanAllowedCount, "Active identifier count is greater than allowed active identifier count", "")
+		}
+	}
+
+	// Union IdPs. IdP in both groups keeps highest IAL, all modes and all accessors.
+	for _, idpToMerge := range refGroupToMerge.Idps {
+		foundThisNodeID := false
+		for iIdP, idp := range refGroup.Idps {
+			if idp.NodeId != idpToMerge.NodeId {
+				continue
+			}
+			refGroup.Idps[iIdP].Active = idp.Active || idpToMerge.Active
+			if idpToMerge.Ial > idp.Ial {
+				refGroup.Idps[iIdP].Ial = idpToMerge.Ial
+			}
+			var modeMap = map[int32]bool{}
+			for _, mode := range idp.Mode {
+				modeMap[mode] = true
+			}
+			for _, mode := range idpToMerge.Mode {
+				modeMap[mode] = true
+			}
+			modeList := make([]int32, 0)
+			for mode := range modeMap {
+				modeList = append(modeList, mode)
+			}
+			sort.Slice(modeList, func(i, j int) bool { return modeList[i] < modeList[j] })
+			refGroup.Idps[iIdP].Mode = modeList
+			refGroup.Idps[iIdP].Accessors = append(refGroup.Idps[iIdP].Accessors, idpToMerge.Accessors...)
+			foundThisNodeID = true
+			break
+		}
+		if !foundThisNodeID {
+			refGroup.Idps = append(refGroup.Idps, idpToMerge)
+		}
+	}
+	refGroupValue, err = utils.ProtoDeterministicMarshal(&refGroup)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	// Keep old group as tombstone pointing to merged group
+	var mergedRefGroup data.ReferenceGroup
+	mergedRefGroup.MergedInto = refGroupCode
+	mergedRefGroupValue, err := utils.ProtoDeterministicMarshal(&mergedRefGroup)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+
+	if mode3 {
+		increaseRequestUseCountResult := app.increaseRequestUseCount(funcParam.RequestID)
+		if increaseRequestUseCountResult.Code != code.OK {
+			return increaseRequestUseCountResult
+		}
+	}
+
+	for _, identity := range refGroupToMerge.Identities {
+		identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + identity.Namespace + keySeparator + identity.IdentifierHash
+		app.state.Set([]byte(identityToRefCodeKey), []byte(refGroupCode))
+	}
+	for _, idp := range refGroupToMerge.Idps {
+		for _, accessor := range idp.Accessors {
+			accessorToRefCodeKey := accessorToRefCodeKeyPrefix + keySeparator + accessor.AccessorId
+			app.state.Set([]byte(accessorToRefCodeKey), []byte(refGroupCode))
+		}
+	}
+	app.state.Set([]byte(refGroupKey), []byte(refGroupValue))
+	app.state.Set([]byte(refGroupToMergeKey), []byte(mergedRefGroupValue))
+	var attributes []cmn.KVPair
+	var attribute cmn.KVPair
+	attribute.Key = []byte("reference_group_code")
+	attribute.Value = []byte(refGroupCode)
+	attributes = append(attributes, attribute)
+	attribute.Key = []byte("merged_reference_group_code")
+	attribute.Value = []byte(refGroupCodeToMerge)
+	attributes = append(attributes, attribute)
+	return app.ReturnDeliverTxLogWithAttributes(code.OK, "success", attributes)
+}
+
+func (app *ABCIApplication) activateIdentity(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("ActivateIdentity, Parameter: %s", param)
+	var funcParam ActivateIdentityParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	return app.setIdentityActive(funcParam.IdentityNamespace, funcParam.IdentityIdentifierHash, funcParam.RequestID, nodeID, true, "ActivateIdentity")
+}
+
+func (app *ABCIApplication) deactivateIdentity(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("DeactivateIdentity, Parameter: %s", param)
+	var funcParam DeactivateIdentityParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	return app.setIdentityActive(funcParam.IdentityNamespace, funcParam.IdentityIdentifierHash, funcParam.RequestID, nodeID, false, "DeactivateIdentity")
+}
+
+func (app *ABCIApplication) setIdentityActive(identityNamespace string, identityIdentifierHash string, requestID string, nodeID string, active bool, purpose string) types.ResponseDeliverTx {
+	if identityNamespace == "" || identityIdentifierHash == "" {
+		return app.ReturnDeliverTxLog(code.IdentityCannotBeEmpty, "Please input identity detail", "")
+	}
+	identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + identityNamespace + keySeparator + identityIdentifierHash
+	refGroupCode, _ := app.state.Get([]byte(identityToRefCodeKey), false)
+	if refGroupCode == nil {
+		return app.ReturnDeliverTxLog(code.RefGroupNotFound, "Reference group not found", "")
+	}
+	refGroupKey := refGroupCodeKeyPrefix + keySeparator + string(refGroupCode)
+	refGroupValue, _ := app.state.Get([]byte(refGroupKey), false)
+	if refGroupValue == nil {
+		return app.ReturnDeliverTxLog(code.RefGroupNotFound, "Reference group not found", "")
+	}
+	var refGroup data.ReferenceGroup
+	err := proto.Unmarshal(refGroupValue, &refGroup)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	foundThisNodeID := false
+	mode3 := false
+	for _, idp := range refGroup.Idps {
+		if idp.NodeId == nodeID && idp.Active {
+			foundThisNodeID = true
+			for _, mode := range idp.Mode {
+				if mode == 3 {
+					mode3 = true
+					break
+				}
+			}
+			break
+		}
+	}
+	if foundThisNodeID == false {
+		return app.ReturnDeliverTxLog(code.IdentityNotFoundInThisIdP, "Identity not found in this IdP", "")
+	}
+	if mode3 {
+		minIdp := 1
+		checkRequestResult := app.checkRequest(requestID, purpose, minIdp)
+		if checkRequestResult.Code != code.OK {
+			return checkRequestResult
+		}
+	}
+	activeCount := 0
+	for index, identity := range refGroup.Identities {
+		if identity.Namespace == identityNamespace && identity.IdentifierHash == identityIdentifierHash {
+			refGroup.Identities[index].Inactive = !active
+		}
+		if !refGroup.Identities[index].Inactive && identity.Namespace == identityNamespace {
+			activeCount++
+		}
+	}
+	if active {
+		allowedActiveIdentifierCount := app.GetNamespaceAllowedActiveIdentifierCountMap(false)
+		if activeCount > allowedActiveIdentifierCount[identityNamespace] && allowedActiveIdentifierCount[identityNamespace] > 0 {
+			return app.ReturnDeliverTxLog(code.ActiveIdentifierCountIsGreaterThanAllowedCount, "Active identifier count is greater than allowed active identifier count", "")
+		}
+	}
+	refGroupValue, err = utils.ProtoDeterministicMarshal(&refGroup)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	if mode3 {
+		increaseRequestUseCountResult := app.increaseRequestUseCount(requestID)
+		if increaseRequestUseCountResult.Code != code.OK {
+			return increaseRequestUseCountResult
+		}
+	}
+	app.state.Set([]byte(refGroupKey), []byte(refGroupValue))
+	var attributes []cmn.KVPair
+	var attribute cmn.KVPair
+	attribute.Key = []byte("reference_group_code")
+	attribute.Value = refGroupCode
+	attributes = append(attributes, attribute)
+	return app.ReturnDeliverTxLogWithAttributes(code.OK, "success", attributes)
+}
+
+// getRefGroupCodeByCodeOrIdentity returns code of reference group identified by
+// either reference group code or identity. Merged group code is resolved to
+// the group it has been merged into. Returns empty string if not found.
+func (app *ABCIApplication) getRefGroupCodeByCodeOrIdentity(refGroupCode string, identityNamespace string, identityIdentifierHash string, committedState bool) string {
+	if refGroupCode != "" {
+		return app.resolveMergedRefGroupCode(refGroupCode, committedState)
+	}
+	identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + identityNamespace + keySeparator + identityIdentifierHash
+	refGroupCodeFromDB, _ := app.state.Get([]byte(identityToRefCodeKey), committedState)
+	if refGroupCodeFromDB == nil {
+		return ""
+	}
+	return string(refGroupCodeFromDB)
+}
+
+// resolveMergedRefGroupCode follows merged reference group tombstones
+// and returns code of the group which is still in use
+func (app *ABCIApplication) resolveMergedRefGroupCode(refGroupCode string, committedState bool) string {
+	for {
+		refGroupKey := refGroupCodeKeyPrefix + keySeparator + refGroupCode
+		refGroupValue, _ := app.state.Get([]byte(refGroupKey), committedState)
+		if refGroupValue == nil {
+			return refGroupCode
+		}
+		var refGroup data.ReferenceGroup
+		err := proto.Unmarshal(refGroupValue, &refGroup)
+		if err != nil || refGroup.MergedInto == "" {
+			return refGroupCode
+		}
+		refGroupCode = refGroup.MergedInto
+	}
+}
+
 func MaxInt32(v []int32) int32 {
 	var m int32
 	for i, e := range v {
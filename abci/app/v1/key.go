@@ -0,0 +1,195 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"crypto"
+	"crypto/dsa"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"golang.org/x/crypto/ed25519"
+)
+
+// Public key algorithms of node and accessor keys
+const (
+	keyAlgorithmRSA            = "RSA"
+	keyAlgorithmECDSASecp256r1 = "ECDSA_SECP256R1"
+	keyAlgorithmECDSASecp256k1 = "ECDSA_SECP256K1"
+	keyAlgorithmEd25519        = "ED25519"
+)
+
+var (
+	oidPublicKeyECDSA   = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidNamedCurveP256K  = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+	oidPublicKeyEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
+
+	errUnsupportedKeyType = errors.New("unsupported key type")
+	errUnknownKeyType     = errors.New("unknown key type")
+)
+
+type subjectPublicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// parsePublicKey parses PEM encoded public key (PKIX) and returns
+// the key and its algorithm. Supported keys are RSA, ECDSA on
+// secp256r1 or secp256k1 curve and Ed25519.
+func parsePublicKey(publicKey string) (key interface{}, algorithm string, err error) {
+	publicKey = strings.Replace(publicKey, "\t", "", -1)
+	block, _ := pem.Decode([]byte(publicKey))
+	if block == nil {
+		return nil, "", errors.New("invalid key format, cannot decode PEM")
+	}
+	// x509 (before Go 1.13) supports neither secp256k1 curve nor Ed25519
+	key, algorithm, ok := parseNonStandardPublicKey(block.Bytes)
+	if ok {
+		if key == nil {
+			return nil, "", errors.New("invalid " + algorithm + " public key")
+		}
+		return key, algorithm, nil
+	}
+	key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, "", err
+	}
+	switch pubKey := key.(type) {
+	case *rsa.PublicKey:
+		return pubKey, keyAlgorithmRSA, nil
+	case *ecdsa.PublicKey:
+		if pubKey.Curve != elliptic.P256() {
+			return nil, "", errUnsupportedKeyType
+		}
+		return pubKey, keyAlgorithmECDSASecp256r1, nil
+	case *dsa.PublicKey:
+		return nil, "", errUnsupportedKeyType
+	default:
+		return nil, "", errUnknownKeyType
+	}
+}
+
+// parseNonStandardPublicKey parses DER encoded PKIX public key on secp256k1
+// curve or Ed25519. ok is false when the key is of neither type. A nil key
+// with ok set means the key has the type's OID but invalid key bytes.
+func parseNonStandardPublicKey(der []byte) (key interface{}, algorithm string, ok bool) {
+	var spki subjectPublicKeyInfo
+	rest, err := asn1.Unmarshal(der, &spki)
+	if err != nil || len(rest) > 0 {
+		return nil, "", false
+	}
+	switch {
+	case spki.Algorithm.Algorithm.Equal(oidPublicKeyEd25519):
+		keyBytes := spki.PublicKey.RightAlign()
+		if len(spki.Algorithm.Parameters.FullBytes) != 0 || len(keyBytes) != ed25519.PublicKeySize {
+			return nil, keyAlgorithmEd25519, true
+		}
+		return ed25519.PublicKey(keyBytes), keyAlgorithmEd25519, true
+	case spki.Algorithm.Algorithm.Equal(oidPublicKeyECDSA):
+		var namedCurve asn1.ObjectIdentifier
+		_, err = asn1.Unmarshal(spki.Algorithm.Parameters.FullBytes, &namedCurve)
+		if err != nil || !namedCurve.Equal(oidNamedCurveP256K) {
+			return nil, "", false
+		}
+		pubKey, err := btcec.ParsePubKey(spki.PublicKey.RightAlign(), btcec.S256())
+		if err != nil {
+			return nil, keyAlgorithmECDSASecp256k1, true
+		}
+		return pubKey, keyAlgorithmECDSASecp256k1, true
+	default:
+		return nil, "", false
+	}
+}
+
+// getPublicKeyAlgorithm returns algorithm of PEM encoded public key
+// or empty string if key is invalid or unsupported
+func getPublicKeyAlgorithm(publicKey string) string {
+	_, algorithm, err := parsePublicKey(publicKey)
+	if err != nil {
+		return ""
+	}
+	return algorithm
+}
+
+// verifyPublicKeySignature verifies signature of message with public key of given algorithm.
+// RSA signature is PKCS#1 v1.5 (or PSS when rsaPSS is set) and ECDSA signature is ASN.1 DER
+// encoded, both over SHA-256 of message. Ed25519 signature is over message itself.
+func verifyPublicKeySignature(publicKey string, algorithm string, message []byte, hashed []byte, signature []byte, rsaPSS bool) error {
+	key, keyAlgorithm, err := parsePublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	if algorithm == "" {
+		algorithm = keyAlgorithm
+	}
+	if algorithm != keyAlgorithm {
+		return fmt.Errorf("key algorithm mismatch: expected %s, got %s", algorithm, keyAlgorithm)
+	}
+	switch algorithm {
+	case keyAlgorithmRSA:
+		if rsaPSS {
+			return rsa.VerifyPSS(key.(*rsa.PublicKey), crypto.SHA256, hashed, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto})
+		}
+		return rsa.VerifyPKCS1v15(key.(*rsa.PublicKey), crypto.SHA256, hashed, signature)
+	case keyAlgorithmECDSASecp256r1:
+		var sig ecdsaSignature
+		rest, err := asn1.Unmarshal(signature, &sig)
+		if err != nil || len(rest) > 0 || sig.R == nil || sig.S == nil {
+			return errors.New("invalid ECDSA signature encoding")
+		}
+		if !ecdsa.Verify(key.(*ecdsa.PublicKey), hashed, sig.R, sig.S) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	case keyAlgorithmECDSASecp256k1:
+		sig, err := btcec.ParseDERSignature(signature, btcec.S256())
+		if err != nil {
+			return err
+		}
+		if !sig.Verify(hashed, key.(*btcec.PublicKey)) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	case keyAlgorithmEd25519:
+		if !ed25519.Verify(key.(ed25519.PublicKey), message, signature) {
+			return errors.New("invalid Ed25519 signature")
+		}
+		return nil
+	default:
+		return errUnsupportedKeyType
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/ndidplatform/smart-contract/v4/abci/code"
 	"github.com/ndidplatform/smart-contract/v4/abci/utils"
 	"github.com/ndidplatform/smart-contract/v4/protos/data"
+	protoTm "github.com/ndidplatform/smart-contract/v4/protos/tendermint"
 )
 
 var isNDIDMethod = map[string]bool{
@@ -66,6 +67,13 @@ var isNDIDMethod = map[string]bool{
 	"SetAllowedModeList":               true,
 	"UpdateNamespace":                  true,
 	"SetAllowedMinIalForRegisterIdentityAtFirstIdp": true,
+	"SetVersionPruningPolicy":                       true,
+	"SetMinimumSignatureScheme":                     true,
+	"SetGovernance":                                 true,
+	"SetIdpResponseFee":                             true,
+	"SetTokenTransferPolicy":                        true,
+	"SetFeePolicy":                                  true,
+	"SetNodeCreditLimit":                            true,
 }
 
 func (app *ABCIApplication) initNDID(param string, nodeID string) types.ResponseDeliverTx {
@@ -75,9 +83,23 @@ func (app *ABCIApplication) initNDID(param string, nodeID string) types.Response
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
+	var tokenDecimals data.TokenDecimals
+	tokenDecimals.Decimals = defaultTokenDecimals
+	if funcParam.TokenDecimals != nil {
+		if *funcParam.TokenDecimals > maxTokenDecimals {
+			return app.ReturnDeliverTxLog(code.InvalidTokenDecimals, "Token decimals must be less than or equal to 9", "")
+		}
+		tokenDecimals.Decimals = *funcParam.TokenDecimals
+	}
+	tokenDecimalsByte, err := utils.ProtoDeterministicMarshal(&tokenDecimals)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
 	var nodeDetail data.NodeDetail
 	nodeDetail.PublicKey = funcParam.PublicKey
+	nodeDetail.PublicKeyAlgorithm = getPublicKeyAlgorithm(funcParam.PublicKey)
 	nodeDetail.MasterPublicKey = funcParam.MasterPublicKey
+	nodeDetail.MasterPublicKeyAlgorithm = getPublicKeyAlgorithm(funcParam.MasterPublicKey)
 	nodeDetail.NodeName = "NDID"
 	nodeDetail.Role = "NDID"
 	nodeDetail.Active = true
@@ -89,8 +111,13 @@ func (app *ABCIApplication) initNDID(param string, nodeID string) types.Response
 	chainHistoryInfoKey := "ChainHistoryInfo"
 	app.state.Set(masterNDIDKeyBytes, []byte(nodeID))
 	app.state.Set([]byte(nodeDetailKey), []byte(nodeDetailByte))
+	err = app.addNodeKey(funcParam.NodeID, nil, funcParam.PublicKey)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
 	app.state.Set(initStateKeyBytes, []byte("true"))
 	app.state.Set([]byte(chainHistoryInfoKey), []byte(funcParam.ChainHistoryInfo))
+	app.state.Set(tokenDecimalsKeyBytes, tokenDecimalsByte)
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -120,7 +147,9 @@ func (app *ABCIApplication) registerNode(param string, nodeID string) types.Resp
 	// create node detail
 	var nodeDetail data.NodeDetail
 	nodeDetail.PublicKey = funcParam.PublicKey
+	nodeDetail.PublicKeyAlgorithm = getPublicKeyAlgorithm(funcParam.PublicKey)
 	nodeDetail.MasterPublicKey = funcParam.MasterPublicKey
+	nodeDetail.MasterPublicKeyAlgorithm = getPublicKeyAlgorithm(funcParam.MasterPublicKey)
 	nodeDetail.NodeName = funcParam.NodeName
 	nodeDetail.Role = funcParam.Role
 	nodeDetail.Active = true
@@ -204,6 +233,10 @@ func (app *ABCIApplication) registerNode(param string, nodeID string) types.Resp
 	}
 	nodeDetailKey := nodeIDKeyPrefix + keySeparator + funcParam.NodeID
 	app.state.Set([]byte(nodeDetailKey), []byte(nodeDetailByte))
+	err = app.addNodeKey(funcParam.NodeID, nil, funcParam.PublicKey)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
 	app.createTokenAccount(funcParam.NodeID)
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
@@ -762,7 +795,6 @@ func (app *ABCIApplication) setTimeOutBlockRegisterIdentity(param string, nodeID
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
-	key := "TimeOutBlockRegisterIdentity"
 	var timeOut data.TimeOutBlockRegisterIdentity
 	timeOut.TimeOutBlock = funcParam.TimeOutBlock
 	// Check time out block > 0
@@ -773,7 +805,7 @@ func (app *ABCIApplication) setTimeOutBlockRegisterIdentity(param string, nodeID
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	app.state.Set([]byte(key), []byte(value))
+	app.state.Set(timeOutBlockRegisterIdentityKeyBytes, []byte(value))
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -1070,6 +1102,49 @@ func (app *ABCIApplication) SetAllowedMinIalForRegisterIdentityAtFirstIdp(param
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
+func (app *ABCIApplication) SetVersionPruningPolicy(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("SetVersionPruningPolicy, Parameter: %s", param)
+	var funcParam SetVersionPruningPolicyParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if funcParam.KeepLastVersions < 0 || funcParam.KeepRecentBlocks < 0 {
+		return app.ReturnDeliverTxLog(code.VersionPruningPolicyValueMustNotBeNegative, "Version pruning policy value must not be negative", "")
+	}
+	var policy data.VersionPruningPolicy
+	policy.KeepLastVersions = funcParam.KeepLastVersions
+	policy.KeepRecentBlocks = funcParam.KeepRecentBlocks
+	policy.KeepOnlyFinalVersionOfClosedRequest = funcParam.KeepOnlyFinalVersionOfClosedRequest
+	policyByte, err := utils.ProtoDeterministicMarshal(&policy)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set(versionPruningPolicyKeyBytes, policyByte)
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+func (app *ABCIApplication) SetMinimumSignatureScheme(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("SetMinimumSignatureScheme, Parameter: %s", param)
+	var funcParam SetMinimumSignatureSchemeParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	signatureScheme, ok := protoTm.SignatureScheme_value[funcParam.SignatureScheme]
+	if !ok {
+		return app.ReturnDeliverTxLog(code.InvalidSignatureScheme, "Invalid signature scheme", "")
+	}
+	var minimumSignatureScheme data.MinimumSignatureScheme
+	minimumSignatureScheme.SignatureScheme = signatureScheme
+	minimumSignatureSchemeByte, err := utils.ProtoDeterministicMarshal(&minimumSignatureScheme)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set(minimumSignatureSchemeKeyBytes, minimumSignatureSchemeByte)
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
 func (app *ABCIApplication) updateNamespace(param string, nodeID string) types.ResponseDeliverTx {
 	app.logger.Infof("UpdateNamespace, Parameter: %s", param)
 	var funcParam UpdateNamespaceParam
@@ -0,0 +1,361 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/abci/utils"
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+	protoTm "github.com/ndidplatform/smart-contract/v4/protos/tendermint"
+)
+
+func nodeKeyHistoryKey(nodeID string) []byte {
+	return []byte(nodeKeyHistoryKeyPrefix + keySeparator + nodeID)
+}
+
+func nodeKeyActivationKey(activationBlock int64, nodeID string) []byte {
+	return []byte(nodeKeyActivationKeyPrefix + keySeparator + fmt.Sprintf("%020d", activationBlock) + keySeparator + nodeID)
+}
+
+func (app *ABCIApplication) getNodeKeyHistory(nodeID string, committedState bool) (*data.NodeKeyHistory, error) {
+	var history data.NodeKeyHistory
+	historyValue, _ := app.state.Get(nodeKeyHistoryKey(nodeID), committedState)
+	if historyValue == nil {
+		return &history, nil
+	}
+	err := proto.Unmarshal(historyValue, &history)
+	if err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+func (app *ABCIApplication) setNodeKeyHistory(nodeID string, history *data.NodeKeyHistory) error {
+	historyValue, err := utils.ProtoDeterministicMarshal(history)
+	if err != nil {
+		return err
+	}
+	app.state.Set(nodeKeyHistoryKey(nodeID), historyValue)
+	return nil
+}
+
+// getNodeKeyHistoryOfNode returns key history of node. Node which has no
+// key history (e.g. from init data) gets its current key as the first entry.
+func (app *ABCIApplication) getNodeKeyHistoryOfNode(nodeID string, nodeDetail *data.NodeDetail, committedState bool) (*data.NodeKeyHistory, error) {
+	history, err := app.getNodeKeyHistory(nodeID, committedState)
+	if err != nil {
+		return nil, err
+	}
+	if len(history.Keys) == 0 {
+		history.Keys = append(history.Keys, &data.NodeKey{
+			PublicKey:          nodeDetail.PublicKey,
+			PublicKeyAlgorithm: nodeDetail.PublicKeyAlgorithm,
+		})
+	}
+	return history, nil
+}
+
+// addNodeKey records public key of node which is valid from current block
+// and ends validity of previous keys of node (nodeDetail is nil for new node).
+// Pending key rotation is cancelled.
+func (app *ABCIApplication) addNodeKey(nodeID string, nodeDetail *data.NodeDetail, publicKey string) error {
+	history := &data.NodeKeyHistory{}
+	if nodeDetail != nil {
+		var err error
+		history, err = app.getNodeKeyHistoryOfNode(nodeID, nodeDetail, false)
+		if err != nil {
+			return err
+		}
+	}
+	height := app.state.CurrentBlockHeight
+	keys := make([]*data.NodeKey, 0, len(history.Keys)+1)
+	for _, key := range history.Keys {
+		if key.ValidFromBlock > height {
+			continue
+		}
+		if key.ValidToBlock == 0 || key.ValidToBlock >= height {
+			key.ValidToBlock = height - 1
+		}
+		keys = append(keys, key)
+	}
+	keys = append(keys, &data.NodeKey{
+		PublicKey:          publicKey,
+		PublicKeyAlgorithm: getPublicKeyAlgorithm(publicKey),
+		ValidFromBlock:     height,
+	})
+	history.Keys = keys
+	return app.setNodeKeyHistory(nodeID, history)
+}
+
+func (app *ABCIApplication) rotateNodeKey(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("RotateNodeKey, Parameter: %s", param)
+	var funcParam RotateNodeKeyParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if funcParam.ActivationBlockHeight <= app.state.CurrentBlockHeight {
+		return app.ReturnDeliverTxLog(code.InvalidActivationBlockHeight, "Activation block height must be greater than current block height", "")
+	}
+	if funcParam.GracePeriodBlock < 0 {
+		return app.ReturnDeliverTxLog(code.InvalidGracePeriodBlock, "Grace period block must not be negative", "")
+	}
+	nodeDetail, err := app.getNodeDetail(nodeID, false)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if nodeDetail == nil {
+		return app.ReturnDeliverTxLog(code.NodeIDNotFound, "Node ID not found", "")
+	}
+	history, err := app.getNodeKeyHistoryOfNode(nodeID, nodeDetail, false)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	for _, key := range history.Keys {
+		if key.ValidFromBlock > app.state.CurrentBlockHeight {
+			return app.ReturnDeliverTxLog(code.NodeKeyRotationIsPending, "Node key rotation is pending", "")
+		}
+	}
+	// Current key is accepted until the end of grace period after activation
+	for _, key := range history.Keys {
+		if key.ValidToBlock == 0 {
+			key.ValidToBlock = funcParam.ActivationBlockHeight + funcParam.GracePeriodBlock
+		}
+	}
+	history.Keys = append(history.Keys, &data.NodeKey{
+		PublicKey:          funcParam.PublicKey,
+		PublicKeyAlgorithm: getPublicKeyAlgorithm(funcParam.PublicKey),
+		ValidFromBlock:     funcParam.ActivationBlockHeight,
+	})
+	err = app.setNodeKeyHistory(nodeID, history)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set(nodeKeyActivationKey(funcParam.ActivationBlockHeight, nodeID), []byte{})
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+// activateNodeKeys replaces public key of nodes which rotated key
+// becomes active at current block
+func (app *ABCIApplication) activateNodeKeys() []types.Event {
+	start := []byte(nodeKeyActivationKeyPrefix + keySeparator)
+	end := nodeKeyActivationKey(app.state.CurrentBlockHeight+1, "")
+	var activationKeys [][]byte
+	app.state.IterateCommitted(start, end, func(key, value []byte) bool {
+		activationKeys = append(activationKeys, append([]byte{}, key...))
+		return true
+	})
+
+	events := make([]types.Event, 0)
+	for _, activationKey := range activationKeys {
+		app.state.Delete(activationKey)
+		parts := strings.SplitN(string(activationKey), keySeparator, 3)
+		activationBlock, _ := strconv.ParseInt(parts[1], 10, 64)
+		nodeID := parts[2]
+		history, err := app.getNodeKeyHistory(nodeID, false)
+		if err != nil {
+			app.logger.Errorf("Activate node key %s: %s", nodeID, err.Error())
+			continue
+		}
+		// Rotation may have been cancelled by UpdateNode
+		var nextKey *data.NodeKey
+		for _, key := range history.Keys {
+			if key.ValidFromBlock == activationBlock && key.ValidToBlock == 0 {
+				nextKey = key
+			}
+		}
+		if nextKey == nil {
+			continue
+		}
+		nodeDetail, err := app.getNodeDetail(nodeID, false)
+		if err != nil || nodeDetail == nil {
+			app.logger.Errorf("Activate node key %s: node detail not found", nodeID)
+			continue
+		}
+		nodeDetail.PublicKey = nextKey.PublicKey
+		nodeDetail.PublicKeyAlgorithm = nextKey.PublicKeyAlgorithm
+		nodeDetailValue, err := utils.ProtoDeterministicMarshal(nodeDetail)
+		if err != nil {
+			app.logger.Errorf("Activate node key %s: %s", nodeID, err.Error())
+			continue
+		}
+		app.state.Set([]byte(nodeIDKeyPrefix+keySeparator+nodeID), nodeDetailValue)
+		app.logger.Infof("Node key activated: %s", nodeID)
+		events = append(events, types.Event{
+			Type: "did.node_key_activated",
+			Attributes: []cmn.KVPair{
+				{Key: []byte("node_id"), Value: []byte(nodeID)},
+			},
+		})
+	}
+	return events
+}
+
+func (app *ABCIApplication) getNodeDetail(nodeID string, committedState bool) (*data.NodeDetail, error) {
+	value, _ := app.state.Get([]byte(nodeIDKeyPrefix+keySeparator+nodeID), committedState)
+	if value == nil {
+		return nil, nil
+	}
+	var nodeDetail data.NodeDetail
+	err := proto.Unmarshal(value, &nodeDetail)
+	if err != nil {
+		return nil, err
+	}
+	return &nodeDetail, nil
+}
+
+// getGracePublicKeys returns public keys of node which are valid at block
+// height of Tx. They are previous keys during grace period of key rotation
+// and the next key which CheckTx sees before its activation is committed.
+func (app *ABCIApplication) getGracePublicKeys(method string, nodeID string, committedState bool) []*data.NodeKey {
+	if method == "InitNDID" || IsMasterKeyMethod[method] {
+		return nil
+	}
+	history, err := app.getNodeKeyHistory(nodeID, committedState)
+	if err != nil {
+		return nil
+	}
+	height := app.getTxBlockHeight(committedState)
+	var keys []*data.NodeKey
+	for _, key := range history.Keys {
+		if key.ValidFromBlock <= height && (key.ValidToBlock == 0 || height <= key.ValidToBlock) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// getTxSignatureKeys returns public keys which Tx signature of node can be
+// verified with, current key of node first. Delegate key has no grace period.
+func (app *ABCIApplication) getTxSignatureKeys(method string, nodeID string, keyID string, publicKey string, keyAlgorithm string, committedState bool) []*data.NodeKey {
+	keys := []*data.NodeKey{{PublicKey: publicKey, PublicKeyAlgorithm: keyAlgorithm}}
+	if keyID != "" {
+		return keys
+	}
+	for _, key := range app.getGracePublicKeys(method, nodeID, committedState) {
+		if key.PublicKey != publicKey {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// getNodeKeysAtBlock returns public keys of node which are valid at block height
+func (app *ABCIApplication) getNodeKeysAtBlock(nodeID string, height int64, committedState bool) ([]*data.NodeKey, error) {
+	nodeDetail, err := app.getNodeDetail(nodeID, committedState)
+	if err != nil {
+		return nil, err
+	}
+	if nodeDetail == nil {
+		return nil, nil
+	}
+	history, err := app.getNodeKeyHistoryOfNode(nodeID, nodeDetail, committedState)
+	if err != nil {
+		return nil, err
+	}
+	var keys []*data.NodeKey
+	for _, key := range history.Keys {
+		if key.ValidFromBlock <= height && (key.ValidToBlock == 0 || height <= key.ValidToBlock) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// verifySignatureWithNodeKeys verifies Tx signature with any of keys.
+// It returns the public key which signature is verified with.
+func verifySignatureWithNodeKeys(param string, nonce []byte, signature []byte, keys []*data.NodeKey, method string, signatureScheme protoTm.SignatureScheme, validUntilBlock int64) (string, error) {
+	var firstErr error
+	for _, key := range keys {
+		verifyResult, err := verifySignature(param, nonce, signature, key.PublicKey, key.PublicKeyAlgorithm, method, signatureScheme, validUntilBlock)
+		if err == nil && verifyResult {
+			return key.PublicKey, nil
+		}
+		if err == nil {
+			err = errors.New("Invalid Tx signature")
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return "", firstErr
+}
+
+func isNodePublicKeyInKeys(publicKey string, keys []*data.NodeKey) bool {
+	for _, key := range keys {
+		if key.PublicKey == publicKey {
+			return true
+		}
+	}
+	return false
+}
+
+func newNodeKeyDetails(keys []*data.NodeKey) []NodeKeyDetail {
+	details := make([]NodeKeyDetail, 0, len(keys))
+	for _, key := range keys {
+		details = append(details, NodeKeyDetail{
+			PublicKey:          key.PublicKey,
+			PublicKeyAlgorithm: key.PublicKeyAlgorithm,
+			ValidFromBlock:     key.ValidFromBlock,
+			ValidToBlock:       key.ValidToBlock,
+		})
+	}
+	return details
+}
+
+func (app *ABCIApplication) getNodeKeyHistoryQuery(param string) types.ResponseQuery {
+	app.logger.Infof("GetNodeKeyHistory, Parameter: %s", param)
+	var funcParam GetNodeKeyHistoryParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	nodeDetail, err := app.getNodeDetail(funcParam.NodeID, true)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	if nodeDetail == nil {
+		return app.ReturnQuery([]byte("{}"), "not found", app.state.Height)
+	}
+	history, err := app.getNodeKeyHistoryOfNode(funcParam.NodeID, nodeDetail, true)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	var result GetNodeKeyHistoryResult
+	result.Keys = newNodeKeyDetails(history.Keys)
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(returnValue, "success", app.state.Height)
+}
@@ -0,0 +1,200 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+)
+
+// maxTxValidityBlocks is the maximum number of blocks after current block
+// which valid until block of Tx can be set to. It bounds how long nonces
+// of Txs with expiry are kept in state.
+const maxTxValidityBlocks int64 = 100000
+
+// defaultNonceValidityBlocks is the number of blocks after block of Tx
+// without expiry (and after migration for nonces of Txs delivered before
+// upgrade) which its nonce is kept in state. Such Tx can be replayed after
+// its nonce is removed so clients should set valid until block of Tx.
+const defaultNonceValidityBlocks int64 = 1000000
+
+// legacyNonceMigrationKeysPerBlock is the number of state keys visited in
+// each block when moving nonces stored as raw keys under nonce key prefix
+const legacyNonceMigrationKeysPerBlock = 1000
+
+// State keys without key separator which are not raw nonce keys. Raw nonce
+// keys are keys without key separator and with empty value.
+var isSingletonStateKey = map[string]bool{
+	string(appStateMetadataKey):                       true,
+	string(masterNDIDKeyBytes):                        true,
+	string(initStateKeyBytes):                         true,
+	string(lastBlockKeyBytes):                         true,
+	string(idpListKeyBytes):                           true,
+	string(allNamespaceKeyBytes):                      true,
+	"rpList":                                          true,
+	"asList":                                          true,
+	"allList":                                         true,
+	"AllService":                                      true,
+	string(versionPruningPolicyKeyBytes):              true,
+	string(versionPruningCursorKeyBytes):              true,
+	string(requestTimeoutIndexBuiltKeyBytes):          true,
+	string(requestTimeoutIndexCursorKeyBytes):         true,
+	string(requestTimeoutIndexBackfillHeightKeyBytes): true,
+	string(timeOutBlockRegisterIdentityKeyBytes):      true,
+	string(minimumSignatureSchemeKeyBytes):            true,
+	string(governanceKeyBytes):                        true,
+	string(idpResponseFeeKeyBytes):                    true,
+	string(tokenTransferPolicyKeyBytes):               true,
+	string(tokenDecimalsKeyBytes):                     true,
+	string(legacyNonceMigratedKeyBytes):               true,
+	string(legacyNonceMigrationCursorKeyBytes):        true,
+}
+
+func nonceKey(nonce []byte) []byte {
+	return append([]byte(nonceKeyPrefix+keySeparator), nonce...)
+}
+
+func nonceExpireKey(expireBlock int64, nonce []byte) []byte {
+	return append([]byte(nonceExpireKeyPrefix+keySeparator+fmt.Sprintf("%020d", expireBlock)+keySeparator), nonce...)
+}
+
+// getTxBlockHeight returns height of block which Tx is included in.
+// In CheckTx (committedState), Tx is included in the block after current block.
+func (app *ABCIApplication) getTxBlockHeight(committedState bool) int64 {
+	if committedState {
+		return app.state.CurrentBlockHeight + 1
+	}
+	return app.state.CurrentBlockHeight
+}
+
+// checkTxValidUntilBlock checks that Tx with expiry can be included in block
+func (app *ABCIApplication) checkTxValidUntilBlock(validUntilBlock int64, committedState bool) (uint32, string) {
+	if validUntilBlock == 0 {
+		return code.OK, ""
+	}
+	height := app.getTxBlockHeight(committedState)
+	if validUntilBlock < height {
+		return code.TxIsExpired, "Tx is expired"
+	}
+	if validUntilBlock > height+maxTxValidityBlocks {
+		return code.TxValidUntilBlockIsTooFar, "Valid until block must not be more than " + strconv.FormatInt(maxTxValidityBlocks, 10) + " blocks after current block"
+	}
+	return code.OK, ""
+}
+
+// setNonce marks nonce as used. Nonce is removed after valid until block of
+// Tx or after default validity blocks if Tx has no expiry.
+func (app *ABCIApplication) setNonce(nonce []byte, validUntilBlock int64) {
+	if validUntilBlock == 0 {
+		validUntilBlock = app.state.CurrentBlockHeight + defaultNonceValidityBlocks
+	}
+	app.state.Set(nonceKey(nonce), []byte{})
+	app.state.Set(nonceExpireKey(validUntilBlock+1, nonce), []byte{})
+	app.deliverTxNonceState[string(nonce)] = []byte(nil)
+}
+
+// isDuplicateNonce reports whether nonce is used. Nonces of Txs delivered
+// before upgrade are raw keys until they are migrated.
+func (app *ABCIApplication) isDuplicateNonce(nonce []byte) bool {
+	if app.state.Has(nonceKey(nonce), false) {
+		return true
+	}
+	if app.state.Has(legacyNonceMigratedKeyBytes, false) || isSingletonStateKey[string(nonce)] {
+		return false
+	}
+	return app.state.Has(nonce, false)
+}
+
+// migrateLegacyNonces moves nonces of Txs delivered before upgrade, which
+// are stored as raw keys, under nonce key prefix with default expiry. State
+// keys are visited in key order in bounded chunks over blocks. Ranges of
+// keys with key separator are skipped since raw nonce keys have none.
+func (app *ABCIApplication) migrateLegacyNonces() {
+	if app.state.Has(legacyNonceMigratedKeyBytes, true) {
+		return
+	}
+	start, _ := app.state.Get(legacyNonceMigrationCursorKeyBytes, false)
+	var nonces [][]byte
+	var nextCursor []byte
+	visitedCount := 0
+	for {
+		var skipTo []byte
+		app.state.IterateCommitted(start, nil, func(key, value []byte) bool {
+			if visitedCount == legacyNonceMigrationKeysPerBlock {
+				nextCursor = append([]byte{}, key...)
+				return false
+			}
+			visitedCount++
+			if index := bytes.Index(key, []byte(keySeparator)); index >= 0 {
+				skipTo = prefixEnd(key[:index+1])
+				return false
+			}
+			if len(value) == 0 && !isSingletonStateKey[string(key)] {
+				nonces = append(nonces, append([]byte{}, key...))
+			}
+			return true
+		})
+		if nextCursor != nil || skipTo == nil {
+			break
+		}
+		start = skipTo
+	}
+	expireBlock := app.state.CurrentBlockHeight + defaultNonceValidityBlocks + 1
+	for _, nonce := range nonces {
+		app.state.Delete(nonce)
+		app.state.Set(nonceKey(nonce), []byte{})
+		app.state.Set(nonceExpireKey(expireBlock, nonce), []byte{})
+	}
+	app.logger.Infof("Legacy nonce migration: %d nonces moved", len(nonces))
+	if nextCursor != nil {
+		app.state.Set(legacyNonceMigrationCursorKeyBytes, nextCursor)
+		return
+	}
+	app.state.Delete(legacyNonceMigrationCursorKeyBytes)
+	app.state.Set(legacyNonceMigratedKeyBytes, []byte("true"))
+	app.logger.Infof("Legacy nonces migrated")
+}
+
+// expireNonces removes nonces of Txs which valid until block has passed
+// since they can no longer be replayed
+func (app *ABCIApplication) expireNonces() {
+	start := []byte(nonceExpireKeyPrefix + keySeparator)
+	end := nonceExpireKey(app.state.CurrentBlockHeight+1, nil)
+	var expiredKeys [][]byte
+	app.state.IterateCommitted(start, end, func(key, value []byte) bool {
+		expiredKeys = append(expiredKeys, append([]byte{}, key...))
+		return true
+	})
+	for _, expiredKey := range expiredKeys {
+		app.state.Delete(expiredKey)
+		nonce := strings.SplitN(string(expiredKey), keySeparator, 3)[2]
+		app.state.Delete(nonceKey([]byte(nonce)))
+	}
+	if len(expiredKeys) > 0 {
+		app.logger.Infof("Expired nonces: %d", len(expiredKeys))
+	}
+}
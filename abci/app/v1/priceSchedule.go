@@ -0,0 +1,184 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/utils"
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+)
+
+// Price schedule of function is a list of prices sorted by effective block
+// height. Price may be set for every node or overridden for nodes of a role
+// or for a node. Price of node override takes precedence over price of role
+// override which takes precedence over price for every node.
+
+var isPriceFuncOverrideRole = map[string]bool{
+	"RP":    true,
+	"IdP":   true,
+	"AS":    true,
+	"Proxy": true,
+}
+
+func (app *ABCIApplication) getPriceFuncSchedule(fnName string, committedState bool) (*data.PriceFuncSchedule, error) {
+	var schedule data.PriceFuncSchedule
+	value, _ := app.state.Get([]byte(priceFuncScheduleKeyPrefix+keySeparator+fnName), committedState)
+	if value == nil {
+		return &schedule, nil
+	}
+	err := proto.Unmarshal(value, &schedule)
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// addPriceFuncScheduleEntry adds entry after entries with the same or lower
+// effective block height so that the latest entry wins at the same height.
+// Entries which are no longer in effect at current block height are removed.
+func (app *ABCIApplication) addPriceFuncScheduleEntry(fnName string, entry *data.PriceFuncScheduleEntry) error {
+	schedule, err := app.getPriceFuncSchedule(fnName, false)
+	if err != nil {
+		return err
+	}
+	index := sort.Search(len(schedule.Entries), func(i int) bool {
+		return schedule.Entries[i].EffectiveBlockHeight > entry.EffectiveBlockHeight
+	})
+	schedule.Entries = append(schedule.Entries, nil)
+	copy(schedule.Entries[index+1:], schedule.Entries[index:])
+	schedule.Entries[index] = entry
+	schedule.Entries = prunePriceFuncSchedule(schedule.Entries, app.state.CurrentBlockHeight)
+	value, err := utils.ProtoDeterministicMarshal(schedule)
+	if err != nil {
+		return err
+	}
+	app.state.Set([]byte(priceFuncScheduleKeyPrefix+keySeparator+fnName), value)
+	return nil
+}
+
+// prunePriceFuncSchedule removes entries which took effect at or before height
+// and are superseded by a later entry for the same node, role or every node.
+// Override removal in effect is removed together with the override.
+func prunePriceFuncSchedule(entries []*data.PriceFuncScheduleEntry, height int64) []*data.PriceFuncScheduleEntry {
+	inEffect := sort.Search(len(entries), func(i int) bool {
+		return entries[i].EffectiveBlockHeight > height
+	})
+	seen := make(map[string]bool)
+	var latest []*data.PriceFuncScheduleEntry
+	for i := inEffect - 1; i >= 0; i-- {
+		target := entries[i].NodeId + keySeparator + entries[i].Role
+		if seen[target] {
+			continue
+		}
+		seen[target] = true
+		if !entries[i].RemoveOverride {
+			latest = append(latest, entries[i])
+		}
+	}
+	result := make([]*data.PriceFuncScheduleEntry, 0, len(latest)+len(entries)-inEffect)
+	for i := len(latest) - 1; i >= 0; i-- {
+		result = append(result, latest[i])
+	}
+	return append(result, entries[inEffect:]...)
+}
+
+// findPriceFuncScheduleEntry returns entry of schedule which is in effect for
+// node of role at block height or nil if there is none
+func findPriceFuncScheduleEntry(schedule *data.PriceFuncSchedule, role string, nodeID string, height int64) *data.PriceFuncScheduleEntry {
+	var nodeEntry, roleEntry, defaultEntry *data.PriceFuncScheduleEntry
+	for _, entry := range schedule.Entries {
+		if entry.EffectiveBlockHeight > height {
+			break
+		}
+		if entry.NodeId != "" {
+			if entry.NodeId == nodeID {
+				nodeEntry = entry
+			}
+		} else if entry.Role != "" {
+			if entry.Role == role {
+				roleEntry = entry
+			}
+		} else {
+			defaultEntry = entry
+		}
+	}
+	for _, entry := range []*data.PriceFuncScheduleEntry{nodeEntry, roleEntry, defaultEntry} {
+		if entry != nil && !entry.RemoveOverride {
+			return entry
+		}
+	}
+	return nil
+}
+
+// getPriceFuncScheduleEntry returns entry of price schedule of function which
+// is in effect for node at block height. Node ID may be empty for price of
+// every node.
+func (app *ABCIApplication) getPriceFuncScheduleEntry(fnName string, nodeID string, height int64, committedState bool) *data.PriceFuncScheduleEntry {
+	schedule, err := app.getPriceFuncSchedule(fnName, committedState)
+	if err != nil || len(schedule.Entries) == 0 {
+		return nil
+	}
+	var role string
+	if nodeID != "" {
+		nodeDetail, err := app.getNodeDetail(nodeID, committedState)
+		if err == nil && nodeDetail != nil {
+			role = nodeDetail.Role
+		}
+	}
+	return findPriceFuncScheduleEntry(schedule, role, nodeID, height)
+}
+
+func (app *ABCIApplication) getPriceFuncScheduleQuery(param string) types.ResponseQuery {
+	app.logger.Infof("GetPriceFuncSchedule, Parameter: %s", param)
+	var funcParam GetPriceFuncScheduleParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	schedule, err := app.getPriceFuncSchedule(funcParam.Func, true)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	decimals := app.getTokenDecimals(true)
+	var result GetPriceFuncScheduleResult
+	result.Entries = make([]PriceFuncScheduleEntry, 0, len(schedule.Entries))
+	for _, entry := range schedule.Entries {
+		result.Entries = append(result.Entries, PriceFuncScheduleEntry{
+			EffectiveBlockHeight: entry.EffectiveBlockHeight,
+			Role:                 entry.Role,
+			NodeID:               entry.NodeId,
+			Price:                formatTokenAmount(entry.Price, decimals),
+			RemoveOverride:       entry.RemoveOverride,
+		})
+	}
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(value, "success", app.state.Height)
+}
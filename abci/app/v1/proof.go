@@ -0,0 +1,200 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	protoProof "github.com/ndidplatform/smart-contract/v4/protos/proof"
+)
+
+// ProofOpSimpleAbsence is proof operator type of SimpleAbsenceOp
+const ProofOpSimpleAbsence = "ndid:absent"
+
+// SimpleMapNeighbor is an item of a simple Merkle map (key and hash of value)
+// with its inclusion proof
+type SimpleMapNeighbor struct {
+	Key       []byte              `json:"key"`
+	ValueHash []byte              `json:"value_hash"`
+	Proof     *merkle.SimpleProof `json:"simple_proof"`
+}
+
+// SimpleAbsenceOp proves that key is not in a simple Merkle map (sorted by
+// key) with the items right before and after where key would be.
+// It takes no argument and produces the root hash of the map. If both
+// neighbors are nil, the map is empty and it produces nothing so that the
+// next operator proves absence of the (empty) map in its parent.
+type SimpleAbsenceOp struct {
+	// Encoded in ProofOp.Key
+	key []byte
+
+	// Encoded in ProofOp.Data
+	Left  *SimpleMapNeighbor `json:"left,omitempty"`
+	Right *SimpleMapNeighbor `json:"right,omitempty"`
+}
+
+var _ merkle.ProofOperator = SimpleAbsenceOp{}
+
+// SimpleAbsenceOpDecoder decodes ProofOp of type ProofOpSimpleAbsence
+func SimpleAbsenceOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
+	if pop.Type != ProofOpSimpleAbsence {
+		return nil, fmt.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpSimpleAbsence)
+	}
+	var op SimpleAbsenceOp
+	err := json.Unmarshal(pop.Data, &op)
+	if err != nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into SimpleAbsenceOp: %s", err.Error())
+	}
+	op.key = pop.Key
+	return op, nil
+}
+
+func (op SimpleAbsenceOp) ProofOp() merkle.ProofOp {
+	data, err := json.Marshal(op)
+	if err != nil {
+		panic(err)
+	}
+	return merkle.ProofOp{
+		Type: ProofOpSimpleAbsence,
+		Key:  op.key,
+		Data: data,
+	}
+}
+
+func (op SimpleAbsenceOp) GetKey() []byte {
+	return op.key
+}
+
+func (op SimpleAbsenceOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf("expected 0 args, got %v", len(args))
+	}
+	if op.Left == nil && op.Right == nil {
+		return [][]byte{}, nil
+	}
+	var root []byte
+	for _, neighbor := range []*SimpleMapNeighbor{op.Left, op.Right} {
+		if neighbor == nil {
+			continue
+		}
+		if neighbor.Proof == nil {
+			return nil, errors.New("neighbor proof is missing")
+		}
+		neighborRoot := neighbor.Proof.ComputeRootHash()
+		if root != nil && !bytes.Equal(root, neighborRoot) {
+			return nil, errors.New("neighbors are not in the same map")
+		}
+		root = neighborRoot
+		item := merkle.KVPair{Key: neighbor.Key, Value: neighbor.ValueHash}.Bytes()
+		err := neighbor.Proof.Verify(root, item)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if op.Left != nil {
+		if bytes.Compare(op.Left.Key, op.key) >= 0 {
+			return nil, errors.New("left neighbor key is not less than key")
+		}
+		if op.Right == nil && op.Left.Proof.Index != op.Left.Proof.Total-1 {
+			return nil, errors.New("left neighbor is not the last item")
+		}
+	}
+	if op.Right != nil {
+		if bytes.Compare(op.Right.Key, op.key) <= 0 {
+			return nil, errors.New("right neighbor key is not greater than key")
+		}
+		if op.Left == nil && op.Right.Proof.Index != 0 {
+			return nil, errors.New("right neighbor is not the first item")
+		}
+	}
+	if op.Left != nil && op.Right != nil {
+		if op.Left.Proof.Total != op.Right.Proof.Total || op.Right.Proof.Index != op.Left.Proof.Index+1 {
+			return nil, errors.New("neighbors are not adjacent")
+		}
+	}
+	return [][]byte{root}, nil
+}
+
+// NewProofRuntime returns proof runtime which can decode proof operators of
+// state tree ("simple:v" and "ndid:absent")
+func NewProofRuntime() *merkle.ProofRuntime {
+	prt := merkle.DefaultProofRuntime()
+	prt.RegisterOpDecoder(ProofOpSimpleAbsence, SimpleAbsenceOpDecoder)
+	return prt
+}
+
+// VerifyStateProof verifies proof operators of state key against app hash.
+// value must be nil to verify absence of key.
+func VerifyStateProof(prt *merkle.ProofRuntime, ops []merkle.ProofOp, appHash []byte, key, value []byte) error {
+	operators, err := prt.DecodeProof(&merkle.Proof{Ops: ops})
+	if err != nil {
+		return err
+	}
+	if len(operators) == 0 {
+		return errors.New("proof is empty")
+	}
+	// Root node must produce app hash
+	if lastOp, ok := operators[len(operators)-1].(SimpleAbsenceOp); ok && lastOp.Left == nil && lastOp.Right == nil {
+		return errors.New("proof of empty state tree")
+	}
+	keyPath := StateTreeKeyPath(key)
+	if value == nil {
+		return operators.Verify(appHash, keyPath, nil)
+	}
+	return operators.VerifyValue(appHash, keyPath, value)
+}
+
+// VerifyQueryProof verifies proof of query response (value is serialized
+// ProvenQueryResult) against app hash of response height and returns the
+// proven result
+func VerifyQueryProof(value []byte, proof *merkle.Proof, appHash []byte) (*protoProof.ProvenQueryResult, error) {
+	var result protoProof.ProvenQueryResult
+	err := proto.Unmarshal(value, &result)
+	if err != nil {
+		return nil, err
+	}
+	if proof == nil || len(proof.Ops) != len(result.State)*(stateTreeDepth+1) {
+		return nil, errors.New("number of proof operators does not match state items")
+	}
+	prt := NewProofRuntime()
+	for i, item := range result.State {
+		ops := proof.Ops[i*(stateTreeDepth+1) : (i+1)*(stateTreeDepth+1)]
+		itemValue := item.Value
+		if !item.Exist {
+			itemValue = nil
+		} else if itemValue == nil {
+			itemValue = []byte{}
+		}
+		err = VerifyStateProof(prt, ops, appHash, item.Key, itemValue)
+		if err != nil {
+			return nil, fmt.Errorf("state key %q: %s", string(item.Key), err.Error())
+		}
+	}
+	return &result, nil
+}
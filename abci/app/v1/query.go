@@ -38,12 +38,51 @@ func (app *ABCIApplication) ReturnQuery(value []byte, log string, height int64)
 	return res
 }
 
+// stateKeyQueryPath is query path for reading raw value of a state key.
+// Query data is the key itself.
+const stateKeyQueryPath = "/key"
+
+func (app *ABCIApplication) queryStateKey(key []byte, prove bool) types.ResponseQuery {
+	app.logger.Infof("Query state key: %s", string(key))
+	value := app.state.dbGetCommitted(key)
+	var res types.ResponseQuery
+	res.Key = key
+	res.Value = value
+	res.Height = app.state.Height
+	if value == nil {
+		res.Log = "not found"
+	} else {
+		res.Log = "success"
+	}
+	if prove {
+		if !app.state.IsStateTreeHeight(app.state.Height) {
+			res.Value = nil
+			res.Log = ErrStateTreeHeightNotReached.Error()
+			return res
+		}
+		res.Proof = app.state.Prove(key)
+	}
+	return res
+}
+
 // QueryRouter is Pointer to function
 func (app *ABCIApplication) QueryRouter(method string, param string, height int64) types.ResponseQuery {
 	result := app.callQuery(method, param, height)
 	return result
 }
 
+// typedQueryResult replaces JSON value of query result with serialized <Method>Result
+func (app *ABCIApplication) typedQueryResult(method string, res types.ResponseQuery) types.ResponseQuery {
+	value, err := encodeTypedQueryResult(method, res.Value)
+	if err != nil {
+		res.Value = nil
+		res.Log = err.Error()
+		return res
+	}
+	res.Value = value
+	return res
+}
+
 func (app *ABCIApplication) callQuery(name string, param string, height int64) types.ResponseQuery {
 	switch name {
 	case "GetNodePublicKey":
@@ -74,6 +113,10 @@ func (app *ABCIApplication) callQuery(name string, param string, height int64) t
 		return app.getServiceList(param)
 	case "GetNodeMasterPublicKey":
 		return app.getNodeMasterPublicKey(param)
+	case "GetNodeKeyHistory":
+		return app.getNodeKeyHistoryQuery(param)
+	case "GetNodeDelegateKeys":
+		return app.getNodeDelegateKeysQuery(param)
 	case "GetNodeInfo":
 		return app.getNodeInfo(param)
 	case "CheckExistingAccessorID":
@@ -106,6 +149,30 @@ func (app *ABCIApplication) callQuery(name string, param string, height int64) t
 		return app.GetAllowedModeList(param)
 	case "GetAllowedMinIalForRegisterIdentityAtFirstIdp":
 		return app.GetAllowedMinIalForRegisterIdentityAtFirstIdp(param)
+	case "GetVersionPruningPolicy":
+		return app.GetVersionPruningPolicy(param)
+	case "GetMinimumSignatureScheme":
+		return app.GetMinimumSignatureScheme(param)
+	case "GetIdpResponseFee":
+		return app.getIdpResponseFeeQuery(param)
+	case "GetTokenTransferPolicy":
+		return app.getTokenTransferPolicyQuery(param)
+	case "GetTokenStatement":
+		return app.getTokenStatement(param, height)
+	case "GetTokenDecimals":
+		return app.getTokenDecimalsQuery(param)
+	case "GetFeePolicy":
+		return app.getFeePolicyQuery(param)
+	case "GetPriceFuncSchedule":
+		return app.getPriceFuncScheduleQuery(param)
+	case "GetNodesByBalanceStatus":
+		return app.getNodesByBalanceStatus(param)
+	case "GetUsageReport":
+		return app.getUsageReport(param, height)
+	case "GetGovernance":
+		return app.getGovernance(param)
+	case "GetNDIDProposal":
+		return app.getNDIDProposal(param)
 	default:
 		return types.ResponseQuery{Code: code.UnknownMethod, Log: "Unknown method name"}
 	}
@@ -24,9 +24,13 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
 
 	"github.com/ndidplatform/smart-contract/v4/abci/code"
 	"github.com/ndidplatform/smart-contract/v4/abci/utils"
@@ -200,12 +204,24 @@ func (app *ABCIApplication) createRequest(param string, nodeID string) types.Res
 	// set chain_id
 	request.ChainId = app.CurrentChain
 
+	retCode, retLog, events := app.escrowRequestFee(&request)
+	if retCode != code.OK {
+		return app.ReturnDeliverTxLog(retCode, retLog, "")
+	}
+
 	value, err := utils.ProtoDeterministicMarshal(&request)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
 	app.state.SetVersioned([]byte(key), []byte(value))
-	return app.ReturnDeliverTxLog(code.OK, "success", request.RequestId)
+	// add request to timeout deadline index
+	if request.RequestTimeout > 0 {
+		deadline := app.CurrentBlockTime.Unix() + request.RequestTimeout
+		app.state.Set(requestTimeoutKey(deadline, request.RequestId), []byte{})
+	}
+	result := app.ReturnDeliverTxLog(code.OK, "success", request.RequestId)
+	result.Events = append(result.Events, events...)
+	return result
 }
 
 func (app *ABCIApplication) closeRequest(param string, nodeID string) types.ResponseDeliverTx {
@@ -252,12 +268,17 @@ func (app *ABCIApplication) closeRequest(param string, nodeID string) types.Resp
 		}
 	}
 	request.Closed = true
-	value, err = utils.ProtoDeterministicMarshal(&request)
+	// make sure request can be saved before escrow is settled
+	_, err = utils.ProtoDeterministicMarshal(&request)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
+	events := app.settleRequestEscrow(&request, "CloseRequest")
+	value, _ = utils.ProtoDeterministicMarshal(&request)
 	app.state.SetVersioned([]byte(key), []byte(value))
-	return app.ReturnDeliverTxLog(code.OK, "success", funcParam.RequestID)
+	result := app.ReturnDeliverTxLog(code.OK, "success", funcParam.RequestID)
+	result.Events = append(result.Events, events...)
+	return result
 }
 
 func (app *ABCIApplication) timeOutRequest(param string, nodeID string) types.ResponseDeliverTx {
@@ -304,12 +325,17 @@ func (app *ABCIApplication) timeOutRequest(param string, nodeID string) types.Re
 		}
 	}
 	request.TimedOut = true
-	value, err = utils.ProtoDeterministicMarshal(&request)
+	// make sure request can be saved before escrow is settled
+	_, err = utils.ProtoDeterministicMarshal(&request)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
+	events := app.settleRequestEscrow(&request, "TimeOutRequest")
+	value, _ = utils.ProtoDeterministicMarshal(&request)
 	app.state.SetVersioned([]byte(key), []byte(value))
-	return app.ReturnDeliverTxLog(code.OK, "success", funcParam.RequestID)
+	result := app.ReturnDeliverTxLog(code.OK, "success", funcParam.RequestID)
+	result.Events = append(result.Events, events...)
+	return result
 }
 
 func (app *ABCIApplication) setDataReceived(param string, nodeID string) types.ResponseDeliverTx {
@@ -370,10 +396,12 @@ func (app *ABCIApplication) setDataReceived(param string, nodeID string) types.R
 	if duplicate == true {
 		return app.ReturnDeliverTxLog(code.DuplicateASInDataRequest, "Duplicate AS ID in data request", "")
 	}
-	// Update received_data_from_list in request
+	// Update received_data_from_list in request and pay AS
+	var events []types.Event
 	for index, dataRequest := range request.DataRequestList {
 		if dataRequest.ServiceId == funcParam.ServiceID {
 			request.DataRequestList[index].ReceivedDataFromList = append(dataRequest.ReceivedDataFromList, funcParam.AsID)
+			events = append(events, app.payASForDataRequest(&request, request.DataRequestList[index], funcParam.AsID)...)
 		}
 	}
 	value, err = utils.ProtoDeterministicMarshal(&request)
@@ -381,5 +409,139 @@ func (app *ABCIApplication) setDataReceived(param string, nodeID string) types.R
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
 	app.state.SetVersioned([]byte(key), []byte(value))
-	return app.ReturnDeliverTxLog(code.OK, "success", funcParam.RequestID)
+	result := app.ReturnDeliverTxLog(code.OK, "success", funcParam.RequestID)
+	result.Events = append(result.Events, events...)
+	return result
+}
+
+// requestTimeoutKey returns key in request timeout deadline index.
+// Deadline (unix time in seconds) is zero-padded so keys are sorted by deadline.
+func requestTimeoutKey(deadline int64, requestID string) []byte {
+	return []byte(requestTimeoutKeyPrefix + keySeparator + fmt.Sprintf("%020d", deadline) + keySeparator + requestID)
+}
+
+// timeOutExpiredRequests marks open requests which deadline (creation block time + timeout)
+// is not after current block time as timed out and removes them from deadline index.
+// Requests are processed in deadline index order so every node produces the same result.
+func (app *ABCIApplication) timeOutExpiredRequests() []types.Event {
+	start := []byte(requestTimeoutKeyPrefix + keySeparator)
+	end := requestTimeoutKey(app.CurrentBlockTime.Unix()+1, "")
+	var expiredKeys [][]byte
+	app.state.IterateCommitted(start, end, func(key, value []byte) bool {
+		expiredKeys = append(expiredKeys, append([]byte{}, key...))
+		return true
+	})
+
+	events := make([]types.Event, 0)
+	for _, expiredKey := range expiredKeys {
+		app.state.Delete(expiredKey)
+		requestID := strings.SplitN(string(expiredKey), keySeparator, 3)[2]
+		key := requestKeyPrefix + keySeparator + requestID
+		value, _ := app.state.GetVersioned([]byte(key), 0, false)
+		if value == nil {
+			continue
+		}
+		var request data.Request
+		err := proto.Unmarshal([]byte(value), &request)
+		if err != nil {
+			app.logger.Errorf("Time out request %s: %s", requestID, err.Error())
+			continue
+		}
+		if request.Closed || request.TimedOut {
+			continue
+		}
+		request.TimedOut = true
+		// make sure request can be saved before escrow is settled
+		_, err = utils.ProtoDeterministicMarshal(&request)
+		if err != nil {
+			app.logger.Errorf("Time out request %s: %s", requestID, err.Error())
+			continue
+		}
+		settlementEvents := app.settleRequestEscrow(&request, "")
+		value, _ = utils.ProtoDeterministicMarshal(&request)
+		app.state.SetVersioned([]byte(key), []byte(value))
+		app.logger.Infof("Request timed out: %s", requestID)
+		events = append(events, types.Event{
+			Type: "did.request_timed_out",
+			Attributes: []cmn.KVPair{
+				{Key: []byte("request_id"), Value: []byte(requestID)},
+			},
+		})
+		events = append(events, settlementEvents...)
+	}
+	return events
+}
+
+// requestTimeoutIndexBackfillKeysPerBlock is the number of request state keys
+// visited in each block when adding requests created before upgrade to the
+// request timeout deadline index
+const requestTimeoutIndexBackfillKeysPerBlock = 1000
+
+// buildRequestTimeoutIndex adds open requests which were created before
+// request timeout deadline index existed to the index. Requests are visited
+// in key order in bounded chunks over blocks starting at the first block after
+// upgrade. Requests created since then are added to the index on creation.
+// Creation block time of these requests is not stored so their deadline is
+// counted from block time when they are visited and they never time out
+// earlier than their timeout.
+func (app *ABCIApplication) buildRequestTimeoutIndex() {
+	if app.state.Has(requestTimeoutIndexBuiltKeyBytes, true) {
+		return
+	}
+	backfillHeight := app.state.CurrentBlockHeight
+	backfillHeightBytes, _ := app.state.Get(requestTimeoutIndexBackfillHeightKeyBytes, false)
+	if backfillHeightBytes == nil {
+		app.state.Set(requestTimeoutIndexBackfillHeightKeyBytes, []byte(strconv.FormatInt(backfillHeight, 10)))
+	} else {
+		backfillHeight, _ = strconv.ParseInt(string(backfillHeightBytes), 10, 64)
+	}
+
+	prefix := []byte(requestKeyPrefix + keySeparator)
+	start, _ := app.state.Get(requestTimeoutIndexCursorKeyBytes, false)
+	if start == nil {
+		start = prefix
+	}
+	versionsSuffix := keySeparator + "versions"
+	var requestIDs []string
+	var nextCursor []byte
+	visitedCount := 0
+	app.state.IterateCommitted(start, prefixEnd(prefix), func(key, value []byte) bool {
+		if visitedCount == requestTimeoutIndexBackfillKeysPerBlock {
+			nextCursor = append([]byte{}, key...)
+			return false
+		}
+		visitedCount++
+		if strings.HasSuffix(string(key), versionsSuffix) {
+			requestIDs = append(requestIDs, strings.TrimSuffix(string(key[len(prefix):]), versionsSuffix))
+		}
+		return true
+	})
+	var count int
+	for _, requestID := range requestIDs {
+		value, _ := app.state.GetVersioned([]byte(requestKeyPrefix+keySeparator+requestID), 0, true)
+		if value == nil {
+			continue
+		}
+		var request data.Request
+		err := proto.Unmarshal(value, &request)
+		if err != nil {
+			app.logger.Errorf("Build request timeout index %s: %s", requestID, err.Error())
+			continue
+		}
+		if request.Closed || request.TimedOut || request.RequestTimeout <= 0 || request.CreationBlockHeight >= backfillHeight {
+			continue
+		}
+		deadline := app.CurrentBlockTime.Unix() + request.RequestTimeout
+		app.state.Set(requestTimeoutKey(deadline, requestID), []byte{})
+		count++
+	}
+	app.logger.Infof("Request timeout index backfill: %d open requests added", count)
+	if nextCursor != nil {
+		app.state.Set(requestTimeoutIndexCursorKeyBytes, nextCursor)
+		return
+	}
+	app.state.Delete(requestTimeoutIndexCursorKeyBytes)
+	app.state.Delete(requestTimeoutIndexBackfillHeightKeyBytes)
+	app.state.Set(requestTimeoutIndexBuiltKeyBytes, []byte("true"))
+	app.logger.Infof("Request timeout index built")
 }
@@ -0,0 +1,264 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/abci/utils"
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+)
+
+// Token settlement of requests: token for data requests and IdP responses is
+// escrowed from request owner at CreateRequest, paid to AS at SetDataReceived,
+// paid to responded IdPs when request is closed or timed out and the rest is
+// refunded to request owner.
+
+// getIdpResponseFee returns fee per IdP response in minor unit
+func (app *ABCIApplication) getIdpResponseFee(committedState bool) int64 {
+	value, _ := app.state.Get(idpResponseFeeKeyBytes, committedState)
+	if value == nil {
+		return 0
+	}
+	var fee data.TokenPrice
+	err := proto.Unmarshal(value, &fee)
+	if err != nil {
+		return 0
+	}
+	return tokenPriceFromState(&fee, app.getTokenDecimals(committedState))
+}
+
+func (app *ABCIApplication) setIdpResponseFee(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("SetIdpResponseFee, Parameter: %s", param)
+	var funcParam SetIdpResponseFeeParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	feeAmount, err := parseTokenAmount(funcParam.Fee, app.getTokenDecimals(false))
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.InvalidTokenAmount, err.Error(), "")
+	}
+	if feeAmount < 0 {
+		return app.ReturnDeliverTxLog(code.PriceMustBeGreaterOrEqualToZero, "Fee must be greater than or equal to zero", "")
+	}
+	var fee data.TokenPrice
+	fee.MinorPrice = feeAmount
+	value, err := utils.ProtoDeterministicMarshal(&fee)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set(idpResponseFeeKeyBytes, value)
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+func (app *ABCIApplication) getIdpResponseFeeQuery(param string) types.ResponseQuery {
+	app.logger.Infof("GetIdpResponseFee, Parameter: %s", param)
+	var result GetIdpResponseFeeResult
+	result.Fee = formatTokenAmount(app.getIdpResponseFee(true), app.getTokenDecimals(true))
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(value, "success", app.state.Height)
+}
+
+func (app *ABCIApplication) getServiceDestinations(serviceID string) (*data.ServiceDesList, error) {
+	var nodes data.ServiceDesList
+	value, _ := app.state.Get([]byte(serviceDestinationKeyPrefix+keySeparator+serviceID), false)
+	if value == nil {
+		return &nodes, nil
+	}
+	err := proto.Unmarshal(value, &nodes)
+	if err != nil {
+		return nil, err
+	}
+	return &nodes, nil
+}
+
+// escrowRequestFee computes token to be escrowed for request and reduces it from
+// request owner. Each AS of data request is escrowed the highest price of the
+// ASes which may answer it (every AS of service when AS list is empty).
+func (app *ABCIApplication) escrowRequestFee(request *data.Request) (uint32, string, []types.Event) {
+	var total int64
+	for _, dataRequest := range request.DataRequestList {
+		nodes, err := app.getServiceDestinations(dataRequest.ServiceId)
+		if err != nil {
+			return code.UnmarshalError, err.Error(), nil
+		}
+		asIDs := make(map[string]bool, len(dataRequest.AsIdList))
+		for _, asID := range dataRequest.AsIdList {
+			asIDs[asID] = true
+		}
+		var feePerAs int64
+		for _, node := range nodes.Node {
+			if len(asIDs) > 0 && !asIDs[node.NodeId] {
+				continue
+			}
+			if node.Price > feePerAs {
+				feePerAs = node.Price
+			}
+		}
+		dataRequest.FeePerAs = feePerAs
+		dataRequest.EscrowAmount, err = mulTokenAmount(feePerAs, dataRequest.MinAs)
+		if err != nil {
+			return code.InvalidTokenAmount, err.Error(), nil
+		}
+		total, err = addTokenAmounts(total, dataRequest.EscrowAmount)
+		if err != nil {
+			return code.InvalidTokenAmount, err.Error(), nil
+		}
+	}
+	var err error
+	request.IdpFeePerResponse = app.getIdpResponseFee(false)
+	request.IdpEscrowAmount, err = mulTokenAmount(request.IdpFeePerResponse, request.MinIdp)
+	if err != nil {
+		return code.InvalidTokenAmount, err.Error(), nil
+	}
+	total, err = addTokenAmounts(total, request.IdpEscrowAmount)
+	if err != nil {
+		return code.InvalidTokenAmount, err.Error(), nil
+	}
+	if total <= 0 {
+		return code.OK, "", nil
+	}
+	retCode, retLog := app.reduceToken(request.Owner, total, tokenLedgerRef{
+		Method:    "CreateRequest",
+		Reason:    tokenLedgerReasonEscrow,
+		RequestID: request.RequestId,
+	})
+	if retCode != code.OK {
+		return retCode, retLog, nil
+	}
+	return code.OK, "", []types.Event{app.newTokenSettlementEvent("did.token_escrowed", request.RequestId, request.Owner, total)}
+}
+
+// payASForDataRequest pays AS which data is received by request owner from
+// escrow of data request. AS is paid its current price of service up to
+// the amount escrowed for each AS.
+func (app *ABCIApplication) payASForDataRequest(request *data.Request, dataRequest *data.DataRequest, asID string) []types.Event {
+	if dataRequest.EscrowAmount <= 0 {
+		return nil
+	}
+	nodes, err := app.getServiceDestinations(dataRequest.ServiceId)
+	if err != nil {
+		app.logger.Errorf("Pay AS %s for request %s: %s", asID, request.RequestId, err.Error())
+		return nil
+	}
+	var amount int64
+	for _, node := range nodes.Node {
+		if node.NodeId == asID {
+			amount = node.Price
+			break
+		}
+	}
+	if amount > dataRequest.FeePerAs {
+		amount = dataRequest.FeePerAs
+	}
+	if amount > dataRequest.EscrowAmount {
+		amount = dataRequest.EscrowAmount
+	}
+	if amount <= 0 {
+		return nil
+	}
+	err = app.addToken(asID, amount, tokenLedgerRef{
+		Method:       "SetDataReceived",
+		Reason:       tokenLedgerReasonRelease,
+		RequestID:    request.RequestId,
+		Counterparty: request.Owner,
+	})
+	if err != nil {
+		app.logger.Errorf("Pay AS %s for request %s: %s", asID, request.RequestId, err.Error())
+		return nil
+	}
+	dataRequest.EscrowAmount -= amount
+	return []types.Event{app.newTokenSettlementEvent("did.token_released", request.RequestId, asID, amount)}
+}
+
+// settleRequestEscrow pays IdPs which responses are not marked invalid by
+// request owner and refunds the rest of escrow to request owner. It is called
+// when request is closed or timed out (method is empty for automatic time out).
+func (app *ABCIApplication) settleRequestEscrow(request *data.Request, method string) []types.Event {
+	events := make([]types.Event, 0)
+	for _, response := range request.ResponseList {
+		if response.ValidIal == "false" || response.ValidSignature == "false" {
+			continue
+		}
+		amount := request.IdpFeePerResponse
+		if amount > request.IdpEscrowAmount {
+			amount = request.IdpEscrowAmount
+		}
+		if amount <= 0 {
+			break
+		}
+		err := app.addToken(response.IdpId, amount, tokenLedgerRef{
+			Method:       method,
+			Reason:       tokenLedgerReasonRelease,
+			RequestID:    request.RequestId,
+			Counterparty: request.Owner,
+		})
+		if err != nil {
+			app.logger.Errorf("Pay IdP %s for request %s: %s", response.IdpId, request.RequestId, err.Error())
+			continue
+		}
+		request.IdpEscrowAmount -= amount
+		events = append(events, app.newTokenSettlementEvent("did.token_released", request.RequestId, response.IdpId, amount))
+	}
+	refund := request.IdpEscrowAmount
+	for _, dataRequest := range request.DataRequestList {
+		refund += dataRequest.EscrowAmount
+	}
+	if refund <= 0 {
+		return events
+	}
+	err := app.addToken(request.Owner, refund, tokenLedgerRef{
+		Method:    method,
+		Reason:    tokenLedgerReasonRefund,
+		RequestID: request.RequestId,
+	})
+	if err != nil {
+		app.logger.Errorf("Refund request %s: %s", request.RequestId, err.Error())
+		return events
+	}
+	request.IdpEscrowAmount = 0
+	for _, dataRequest := range request.DataRequestList {
+		dataRequest.EscrowAmount = 0
+	}
+	return append(events, app.newTokenSettlementEvent("did.token_refunded", request.RequestId, request.Owner, refund))
+}
+
+func (app *ABCIApplication) newTokenSettlementEvent(eventType string, requestID string, nodeID string, amount int64) types.Event {
+	return types.Event{
+		Type: eventType,
+		Attributes: []cmn.KVPair{
+			{Key: []byte("request_id"), Value: []byte(requestID)},
+			{Key: []byte("node_id"), Value: []byte(nodeID)},
+			{Key: []byte("amount"), Value: []byte(formatTokenAmount(amount, app.getTokenDecimals(false)))},
+		},
+	}
+}
@@ -0,0 +1,310 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/utils"
+	"github.com/ndidplatform/smart-contract/v4/abci/version"
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+	"github.com/ndidplatform/smart-contract/v4/protos/snapshot"
+)
+
+const (
+	snapshotFormatVersion = 1
+	// Max size of a message in snapshot stream
+	snapshotMaxMessageSize = 64 * 1024 * 1024
+	// Number of items written to DB per batch on import
+	snapshotImportBatchSize = 100000
+)
+
+// ExportState writes app state in db at height (0 means current height of
+// state in db) to w as a snapshot stream. Versioned keys are exported with
+// their versions up to height. Other keys are only stored at the latest
+// height so app hash of a snapshot at an older height is unknown and the
+// snapshot can only be imported in migration mode.
+func ExportState(db dbm.DB, height int64, w io.Writer) (metadata AppStateMetadata, err error) {
+	metadata = loadAppStateMetadata(db)
+	if height == 0 {
+		height = metadata.Height
+	}
+	if height < 0 || height > metadata.Height {
+		return metadata, fmt.Errorf("invalid height %d, height of state in DB is %d", height, metadata.Height)
+	}
+
+	writer := snapshotWriter{
+		w:    bufio.NewWriter(w),
+		hash: sha256.New(),
+	}
+
+	var itemCount int64
+	err = iterateStateItems(db, height, func(key, value []byte) error {
+		itemCount++
+		return nil
+	})
+	if err != nil {
+		return metadata, err
+	}
+
+	var header snapshot.SnapshotHeader
+	header.FormatVersion = snapshotFormatVersion
+	header.Height = height
+	if height == metadata.Height {
+		header.AppHash = metadata.AppHash
+	}
+	header.AbciAppVersion = version.Version
+	header.ItemCount = itemCount
+	header.StateTreeHeight = metadata.StateTreeHeight
+	err = writer.writeMessage(&header)
+	if err != nil {
+		return metadata, err
+	}
+
+	err = iterateStateItems(db, height, func(key, value []byte) error {
+		var item snapshot.SnapshotItem
+		item.Key = key
+		item.Value = value
+		return writer.writeMessage(&item)
+	})
+	if err != nil {
+		return metadata, err
+	}
+
+	var footer snapshot.SnapshotFooter
+	footer.Checksum = writer.hash.Sum(nil)
+	err = writer.writeMessage(&footer)
+	if err != nil {
+		return metadata, err
+	}
+	metadata.Height = height
+	metadata.AppHash = header.AppHash
+	return metadata, writer.w.Flush()
+}
+
+// ImportState rebuilds app state in an empty db from snapshot stream r
+// and verifies that resulting app hash matches the one in snapshot.
+// Snapshot without verifiable app hash (exported at an older height or
+// before app hash is switched to state tree, see StateTreeHeight) can only be
+// imported with migrate. In migration mode, app hash is not verified and
+// root hash of state tree is recorded as app hash at snapshot height.
+// On error, db may contain partially imported state and should be discarded.
+func ImportState(db dbm.DB, r io.Reader, migrate bool) (metadata AppStateMetadata, err error) {
+	itr := db.Iterator(nil, nil)
+	dbIsEmpty := !itr.Valid()
+	itr.Close()
+	if !dbIsEmpty {
+		return metadata, errors.New("DB is not empty")
+	}
+
+	reader := snapshotReader{
+		r:    bufio.NewReader(r),
+		hash: sha256.New(),
+	}
+
+	var header snapshot.SnapshotHeader
+	err = reader.readMessage(&header)
+	if err != nil {
+		return metadata, err
+	}
+	if header.FormatVersion != snapshotFormatVersion {
+		return metadata, fmt.Errorf("unsupported snapshot format version: %d", header.FormatVersion)
+	}
+	verifiable := len(header.AppHash) > 0 && header.StateTreeHeight != 0 && header.Height >= header.StateTreeHeight
+	if !migrate && !verifiable {
+		return metadata, errors.New("app hash of snapshot is not root hash of state tree and cannot be verified, use migration mode")
+	}
+
+	appState := NewAppState(db)
+	appState.CurrentBlockHeight = header.Height
+	for i := int64(1); i <= header.ItemCount; i++ {
+		var item snapshot.SnapshotItem
+		err = reader.readMessage(&item)
+		if err != nil {
+			return metadata, err
+		}
+		value := item.Value
+		if value == nil {
+			value = []byte{}
+		}
+		appState.setRaw(item.Key, value)
+		if i%snapshotImportBatchSize == 0 {
+			appState.Save()
+		}
+	}
+
+	checksum := reader.hash.Sum(nil)
+	var footer snapshot.SnapshotFooter
+	err = reader.readMessage(&footer)
+	if err != nil {
+		return metadata, err
+	}
+	if !bytes.Equal(footer.Checksum, checksum) {
+		return metadata, errors.New("snapshot checksum mismatch")
+	}
+	appState.Save()
+
+	appHash := appState.Hash()
+	if migrate {
+		appState.StateTreeHeight = header.Height
+	} else {
+		if !bytes.Equal(appHash, header.AppHash) {
+			return metadata, fmt.Errorf("app hash mismatch: expected %X, got %X", header.AppHash, appHash)
+		}
+		appState.StateTreeHeight = header.StateTreeHeight
+	}
+
+	appState.Height = header.Height
+	appState.AppHash = appHash
+	appState.SaveMetadata()
+	return appState.AppStateMetadata, nil
+}
+
+// iterateStateItems calls fn for each app state key/value in db at height
+// in key order (state tree and metadata are excluded). Versions of versioned
+// keys after height are excluded and other keys are at the latest height.
+// Iteration stops at the first error returned by fn.
+func iterateStateItems(db dbm.DB, height int64, fn func(key, value []byte) error) error {
+	itr := db.Iterator(nil, nil)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		key := itr.Key()
+		value := itr.Value()
+		if bytes.Equal(key, appStateMetadataKey) || isStateTreeKey(key) {
+			continue
+		}
+		if isVersionsKey(key) {
+			var keyVersions data.KeyVersions
+			err := proto.Unmarshal(value, &keyVersions)
+			if err != nil {
+				return err
+			}
+			_, err = findVersion(keyVersions.Versions, keyVersions.PrunedFirstVersion, height)
+			if err != nil {
+				return fmt.Errorf("%s at height %d: %v", string(key), height, err)
+			}
+			versionCount := 0
+			for versionCount < len(keyVersions.Versions) && keyVersions.Versions[versionCount] <= height {
+				versionCount++
+			}
+			if versionCount == 0 {
+				continue
+			}
+			if versionCount < len(keyVersions.Versions) {
+				keyVersions.Versions = keyVersions.Versions[:versionCount]
+				value, err = utils.ProtoDeterministicMarshal(&keyVersions)
+				if err != nil {
+					return err
+				}
+			}
+		} else if version, ok := keyVersion(key); ok && version > height {
+			continue
+		}
+		err := fn(key, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keyVersion returns version of key if key is a version of versioned key
+// (<key>|<height>, see AppState.SetVersioned)
+func keyVersion(key []byte) (int64, bool) {
+	separatorIndex := bytes.LastIndex(key, []byte(keySeparator))
+	if separatorIndex < 0 {
+		return 0, false
+	}
+	version, err := strconv.ParseInt(string(key[separatorIndex+1:]), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	versionsKey := append(append([]byte{}, key[:separatorIndex]...), "|versions"...)
+	return version, isVersionsKey(versionsKey)
+}
+
+type snapshotWriter struct {
+	w    *bufio.Writer
+	hash hash.Hash
+}
+
+func (writer *snapshotWriter) writeMessage(message proto.Message) error {
+	messageBytes, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+	lengthBytes := proto.EncodeVarint(uint64(len(messageBytes)))
+	for _, b := range [][]byte{lengthBytes, messageBytes} {
+		writer.hash.Write(b)
+		_, err = writer.w.Write(b)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type snapshotReader struct {
+	r    *bufio.Reader
+	hash hash.Hash
+}
+
+func (reader *snapshotReader) readMessageBytes() ([]byte, error) {
+	length, err := binary.ReadUvarint(reader.r)
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if length > snapshotMaxMessageSize {
+		return nil, fmt.Errorf("snapshot message too large: %d bytes", length)
+	}
+	messageBytes := make([]byte, length)
+	_, err = io.ReadFull(reader.r, messageBytes)
+	if err != nil {
+		return nil, err
+	}
+	reader.hash.Write(proto.EncodeVarint(length))
+	reader.hash.Write(messageBytes)
+	return messageBytes, nil
+}
+
+func (reader *snapshotReader) readMessage(message proto.Message) error {
+	messageBytes, err := reader.readMessageBytes()
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(messageBytes, message)
+}
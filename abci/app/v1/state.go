@@ -23,15 +23,21 @@
 package app
 
 import (
-	"encoding/binary"
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	dbm "github.com/tendermint/tendermint/libs/db"
 
 	"github.com/ndidplatform/smart-contract/v4/abci/utils"
 	"github.com/ndidplatform/smart-contract/v4/protos/data"
+	protoProof "github.com/ndidplatform/smart-contract/v4/protos/proof"
 )
 
 var (
@@ -39,18 +45,41 @@ var (
 	// nonceKeyPrefix  = []byte("nonce:")
 )
 
+// ErrVersionPruned is returned when requested version of a versioned key
+// has been discarded by version pruning policy
+var ErrVersionPruned = errors.New("requested version has been pruned")
+
+// ErrStateTreeHeightNotReached is returned when proof is requested before app
+// hash has been switched to root hash of state tree
+var ErrStateTreeHeightNotReached = errors.New("proofs are not available before state tree height")
+
 type AppStateMetadata struct {
 	Height  int64  `json:"height"`
 	AppHash []byte `json:"app_hash"`
+	// Height of the first block which app hash is root hash of state tree.
+	// App hash of earlier blocks is a hash chain of changed data.
+	// 0 means app hash has not been switched to state tree yet.
+	StateTreeHeight int64 `json:"state_tree_height,omitempty"`
 }
 
 type AppState struct {
 	AppStateMetadata
-	db                       dbm.DB
-	CurrentBlockHeight       int64
+	db                 dbm.DB
+	tree               *stateTree
+	CurrentBlockHeight int64
+	// Changed keys and values of last saved block in key order
+	// (used for app hash before state tree height)
 	HashData                 []byte
 	uncommittedState         map[string][]byte
 	uncommittedVersionsState map[string][]int64
+	// First version (height) of pruned versions of keys in uncommittedVersionsState
+	uncommittedPrunedFirstVersion map[string]int64
+	// Height used for reading versioned keys from committed state (0 means latest)
+	committedReadHeight int64
+	// Keys read from committed state while recording (used for query proofs)
+	committedReadKeys   [][]byte
+	committedReadKeySet map[string]bool
+	recordReads         bool
 }
 
 func NewAppState(db dbm.DB) (appState AppState) {
@@ -58,14 +87,46 @@ func NewAppState(db dbm.DB) (appState AppState) {
 	appState = AppState{
 		AppStateMetadata:         appStateMetadata,
 		db:                       db,
+		tree:                     newStateTree(db),
 		CurrentBlockHeight:       appStateMetadata.Height,
 		HashData:                 make([]byte, 0),
 		uncommittedState:         make(map[string][]byte),
 		uncommittedVersionsState: make(map[string][]int64),
+
+		uncommittedPrunedFirstVersion: make(map[string]int64),
+	}
+	if len(db.Get(appStateMetadataKey)) == 0 {
+		// New chain, app hash is root hash of state tree from the first block
+		appState.StateTreeHeight = 1
+	} else if appState.tree.root == nil {
+		// Upgrade from a version without state tree
+		appState.tree = rebuildStateTree(db)
 	}
 	return appState
 }
 
+// SetStateTreeHeight sets height of the first block which app hash is root
+// hash of state tree. It must be the same on every node of a chain and cannot
+// be changed after app hash has been switched.
+func (appState *AppState) SetStateTreeHeight(height int64) error {
+	if height == 0 || height == appState.StateTreeHeight {
+		return nil
+	}
+	if appState.StateTreeHeight != 0 && appState.StateTreeHeight <= appState.Height {
+		return fmt.Errorf("app hash has been switched to state tree at height %d", appState.StateTreeHeight)
+	}
+	if height <= appState.Height {
+		return fmt.Errorf("state tree height %d must be greater than current height %d", height, appState.Height)
+	}
+	appState.StateTreeHeight = height
+	return nil
+}
+
+// IsStateTreeHeight reports whether app hash at height is root hash of state tree
+func (appState *AppState) IsStateTreeHeight(height int64) bool {
+	return appState.StateTreeHeight != 0 && height >= appState.StateTreeHeight
+}
+
 func loadAppStateMetadata(db dbm.DB) AppStateMetadata {
 	appStateMetadataBytes := db.Get(appStateMetadataKey)
 	var appStateMetadata AppStateMetadata
@@ -86,10 +147,17 @@ func (appState *AppState) SaveMetadata() {
 	appState.db.Set(appStateMetadataKey, appStateMetadataBytes)
 }
 
+// Set sets value of key. Keys registered as versioned (see isVersionedKey)
+// are stored as a new version of the key.
 func (appState *AppState) Set(key, value []byte) {
-	appState.HashData = append(appState.HashData, key...)
-	appState.HashData = append(appState.HashData, value...)
+	if isVersionedKey(key) {
+		appState.SetVersioned(key, value)
+		return
+	}
+	appState.setRaw(key, value)
+}
 
+func (appState *AppState) setRaw(key, value []byte) {
 	appState.uncommittedState[string(key)] = value
 }
 
@@ -108,25 +176,18 @@ func (appState *AppState) SetVersioned(key, value []byte) {
 				panic(err) // Should panic or return err?
 			}
 			versions = keyVersions.Versions
+			if keyVersions.PrunedFirstVersion != 0 {
+				appState.uncommittedPrunedFirstVersion[versionsKeyStr] = keyVersions.PrunedFirstVersion
+			}
 		}
 	}
 
 	if len(versions) == 0 || versions[len(versions)-1] != appState.CurrentBlockHeight {
-		appState.HashData = append(appState.HashData, versionsKey...)
-		versionBytes := make([]byte, 8)
-		for _, version := range versions {
-			binary.BigEndian.PutUint64(versionBytes, uint64(version))
-			appState.HashData = append(appState.HashData, versionBytes...)
-		}
-
 		appState.uncommittedVersionsState[versionsKeyStr] = append(versions, appState.CurrentBlockHeight)
 	}
 
 	keyWithVersionStr := string(key) + "|" + strconv.FormatInt(appState.CurrentBlockHeight, 10)
 
-	appState.HashData = append(appState.HashData, key...)
-	appState.HashData = append(appState.HashData, value...)
-
 	appState.uncommittedState[keyWithVersionStr] = value
 }
 
@@ -139,6 +200,9 @@ func (appState *AppState) Get(key []byte, committed bool) (value []byte, err err
 }
 
 func (appState *AppState) get(key []byte) (value []byte, err error) {
+	if isVersionedKey(key) {
+		return appState.getVersioned(key, 0)
+	}
 	var existInUncommittedState bool
 	value, existInUncommittedState = appState.uncommittedState[string(key)]
 	if !existInUncommittedState {
@@ -149,7 +213,10 @@ func (appState *AppState) get(key []byte) (value []byte, err error) {
 }
 
 func (appState *AppState) getCommitted(key []byte) (value []byte, err error) {
-	value = appState.db.Get(key)
+	if isVersionedKey(key) {
+		return appState.getCommittedVersioned(key, appState.committedReadHeight)
+	}
+	value = appState.dbGetCommitted(key)
 	return value, nil
 }
 
@@ -166,9 +233,12 @@ func (appState *AppState) getVersioned(key []byte, height int64) (value []byte,
 	versionsKey := []byte(versionsKeyStr)
 
 	var versions []int64
+	var prunedFirstVersion int64
 	var existInUncommittedState bool
 	versions, existInUncommittedState = appState.uncommittedVersionsState[versionsKeyStr]
-	if !existInUncommittedState {
+	if existInUncommittedState {
+		prunedFirstVersion = appState.uncommittedPrunedFirstVersion[versionsKeyStr]
+	} else {
 		keyVersionsProtobuf := appState.db.Get(versionsKey)
 		if keyVersionsProtobuf != nil {
 			var keyVersions data.KeyVersions
@@ -177,30 +247,19 @@ func (appState *AppState) getVersioned(key []byte, height int64) (value []byte,
 				return nil, err
 			}
 			versions = keyVersions.Versions
+			prunedFirstVersion = keyVersions.PrunedFirstVersion
 		}
 	}
 
-	if len(versions) == 0 {
-		return nil, nil
-	}
-
-	var version int64
-	if height <= 0 {
-		version = versions[len(versions)-1]
-	} else {
-		for i := len(versions) - 1; i >= 0; i-- {
-			version = versions[i]
-			if version <= height {
-				break
-			}
-		}
+	version, err := findVersion(versions, prunedFirstVersion, height)
+	if err != nil || version == 0 {
+		return nil, err
 	}
 
 	keyWithVersionStr := string(key) + "|" + strconv.FormatInt(version, 10)
 
-	if existInUncommittedState {
-		value = appState.uncommittedState[keyWithVersionStr]
-	} else {
+	value, existInUncommittedState = appState.uncommittedState[keyWithVersionStr]
+	if !existInUncommittedState {
 		keyWithVersion := []byte(keyWithVersionStr)
 		value = appState.db.Get(keyWithVersion)
 	}
@@ -213,7 +272,7 @@ func (appState *AppState) getCommittedVersioned(key []byte, height int64) (value
 	versionsKey := []byte(versionsKeyStr)
 
 	var versions []int64
-	keyVersionsProtobuf := appState.db.Get(versionsKey)
+	keyVersionsProtobuf := appState.dbGetCommitted(versionsKey)
 	var keyVersions data.KeyVersions
 	err = proto.Unmarshal([]byte(keyVersionsProtobuf), &keyVersions)
 	if err != nil {
@@ -221,29 +280,44 @@ func (appState *AppState) getCommittedVersioned(key []byte, height int64) (value
 	}
 	versions = keyVersions.Versions
 
-	if len(versions) == 0 {
-		return nil, nil
-	}
-
-	var version int64
-	if height <= 0 {
-		version = versions[len(versions)-1]
-	} else {
-		for i := len(versions) - 1; i >= 0; i-- {
-			version = versions[i]
-			if version <= height {
-				break
-			}
-		}
+	version, err := findVersion(versions, keyVersions.PrunedFirstVersion, height)
+	if err != nil || version == 0 {
+		return nil, err
 	}
 
 	keyWithVersionStr := string(key) + "|" + strconv.FormatInt(version, 10)
 	keyWithVersion := []byte(keyWithVersionStr)
 
-	value = appState.db.Get(keyWithVersion)
+	value = appState.dbGetCommitted(keyWithVersion)
 	return value, nil
 }
 
+// findVersion returns version of versioned key for height
+// (latest version if height <= 0). Returns 0 if there is no version at height.
+func findVersion(versions []int64, prunedFirstVersion int64, height int64) (int64, error) {
+	if len(versions) == 0 {
+		return 0, nil
+	}
+	if height <= 0 {
+		return versions[len(versions)-1], nil
+	}
+	if height < versions[0] {
+		if prunedFirstVersion != 0 && height >= prunedFirstVersion {
+			return 0, ErrVersionPruned
+		}
+		// Key did not exist at height
+		return 0, nil
+	}
+	var version int64
+	for i := len(versions) - 1; i >= 0; i-- {
+		version = versions[i]
+		if version <= height {
+			break
+		}
+	}
+	return version, nil
+}
+
 func (appState *AppState) Has(key []byte, committed bool) bool {
 	if committed {
 		return appState.hasCommitted(key)
@@ -253,14 +327,26 @@ func (appState *AppState) Has(key []byte, committed bool) bool {
 }
 
 func (appState *AppState) has(key []byte) bool {
-	_, existInUncommittedState := appState.uncommittedState[string(key)]
+	if isVersionedKey(key) {
+		value, _ := appState.getVersioned(key, 0)
+		return value != nil
+	}
+	value, existInUncommittedState := appState.uncommittedState[string(key)]
 	if existInUncommittedState {
-		return true
+		// nil value is a deleted key
+		return value != nil
 	}
 	return appState.db.Has(key)
 }
 
 func (appState *AppState) hasCommitted(key []byte) bool {
+	if isVersionedKey(key) {
+		value, _ := appState.getCommittedVersioned(key, appState.committedReadHeight)
+		return value != nil
+	}
+	if appState.recordReads {
+		appState.recordCommittedRead(key)
+	}
 	return appState.db.Has(key)
 }
 
@@ -287,16 +373,17 @@ func (appState *AppState) hasVersioned(key []byte) bool {
 func (appState *AppState) hasCommittedVersioned(key []byte) bool {
 	versionsKeyStr := string(key) + "|versions"
 	versionsKey := []byte(versionsKeyStr)
-	return appState.db.Has(versionsKey)
+	return appState.hasCommitted(versionsKey)
 }
 
 func (appState *AppState) Delete(key []byte) {
 	if !appState.has(key) {
 		return
 	}
-	appState.HashData = append(appState.HashData, key...)
-	appState.HashData = append(appState.HashData, []byte("delete")...) // Remove or replace with something else?
-
+	if isVersionedKey(key) {
+		appState.SetVersioned(key, nil)
+		return
+	}
 	appState.uncommittedState[string(key)] = nil
 }
 
@@ -311,6 +398,8 @@ func (appState *AppState) Save() {
 	batch := appState.db.NewBatch()
 	defer batch.Close()
 
+	changes := make(map[string][]byte, len(appState.uncommittedState)+len(appState.uncommittedVersionsState))
+
 	for key := range appState.uncommittedState {
 		value := appState.uncommittedState[key]
 		if value != nil {
@@ -318,21 +407,271 @@ func (appState *AppState) Save() {
 		} else {
 			batch.Delete([]byte(key))
 		}
+		changes[key] = value
 	}
 
 	for key := range appState.uncommittedVersionsState {
 		versions := appState.uncommittedVersionsState[key]
 		var keyVersions data.KeyVersions
 		keyVersions.Versions = versions
+		keyVersions.PrunedFirstVersion = appState.uncommittedPrunedFirstVersion[key]
 		value, err := utils.ProtoDeterministicMarshal(&keyVersions)
 		if err != nil {
 			panic(err) // Should panic or return err?
 		}
 		batch.Set([]byte(key), value)
+		changes[key] = value
 	}
 
+	appState.tree.Update(batch, changes)
+
 	batch.WriteSync()
 
+	changedKeys := make([]string, 0, len(changes))
+	for key := range changes {
+		changedKeys = append(changedKeys, key)
+	}
+	sort.Strings(changedKeys)
+	appState.HashData = make([]byte, 0)
+	for _, key := range changedKeys {
+		appState.HashData = append(appState.HashData, key...)
+		appState.HashData = append(appState.HashData, changes[key]...)
+	}
+
 	appState.uncommittedState = make(map[string][]byte)
 	appState.uncommittedVersionsState = make(map[string][]int64)
+	appState.uncommittedPrunedFirstVersion = make(map[string]int64)
+}
+
+// StateCheckpoint is a copy of uncommitted state which can be restored
+// with Rollback to discard changes made after the checkpoint
+type StateCheckpoint struct {
+	uncommittedState              map[string][]byte
+	uncommittedVersionsState      map[string][]int64
+	uncommittedPrunedFirstVersion map[string]int64
+}
+
+// Checkpoint returns a copy of current uncommitted state
+func (appState *AppState) Checkpoint() StateCheckpoint {
+	checkpoint := StateCheckpoint{
+		uncommittedState:              make(map[string][]byte, len(appState.uncommittedState)),
+		uncommittedVersionsState:      make(map[string][]int64, len(appState.uncommittedVersionsState)),
+		uncommittedPrunedFirstVersion: make(map[string]int64, len(appState.uncommittedPrunedFirstVersion)),
+	}
+	for key, value := range appState.uncommittedState {
+		checkpoint.uncommittedState[key] = value
+	}
+	for key, versions := range appState.uncommittedVersionsState {
+		checkpoint.uncommittedVersionsState[key] = append([]int64{}, versions...)
+	}
+	for key, version := range appState.uncommittedPrunedFirstVersion {
+		checkpoint.uncommittedPrunedFirstVersion[key] = version
+	}
+	return checkpoint
+}
+
+// Rollback restores uncommitted state to checkpoint
+func (appState *AppState) Rollback(checkpoint StateCheckpoint) {
+	appState.uncommittedState = checkpoint.uncommittedState
+	appState.uncommittedVersionsState = checkpoint.uncommittedVersionsState
+	appState.uncommittedPrunedFirstVersion = checkpoint.uncommittedPrunedFirstVersion
+}
+
+// PruneVersions discards old versions of versioned keys written in current
+// block according to policy. Latest version of a key is always kept.
+// isFinalVersion reports whether latest value of a key is final
+// (all other versions of the key are discarded).
+// Pruned versions are always the oldest ones so the remaining versions
+// list stays sorted and is the same on every node.
+func (appState *AppState) PruneVersions(policy *data.VersionPruningPolicy, isFinalVersion func(key string, value []byte) bool) {
+	versionsKeys := make([]string, 0, len(appState.uncommittedVersionsState))
+	for versionsKey := range appState.uncommittedVersionsState {
+		versionsKeys = append(versionsKeys, versionsKey)
+	}
+	sort.Strings(versionsKeys)
+
+	for _, versionsKey := range versionsKeys {
+		versions := appState.uncommittedVersionsState[versionsKey]
+		if len(versions) <= 1 {
+			continue
+		}
+		key := strings.TrimSuffix(versionsKey, "|versions")
+		latestValue := appState.uncommittedState[key+"|"+strconv.FormatInt(versions[len(versions)-1], 10)]
+		appState.pruneKeyVersions(policy, isFinalVersion, versionsKey, versions, latestValue)
+	}
+}
+
+// SweepPruneVersions applies policy to versioned keys in committed state
+// which are not written in current block so that keys which are never
+// written again are pruned too. It visits at most maxKeys state keys in key
+// order starting at cursor and returns the key to continue from in the next
+// block (nil when the end of state is reached).
+// isVersionsKey reports whether key is a versions index key.
+func (appState *AppState) SweepPruneVersions(policy *data.VersionPruningPolicy, isFinalVersion func(key string, value []byte) bool, isVersionsKey func(key []byte) bool, cursor []byte, maxKeys int) []byte {
+	visitedCount := 0
+	start := cursor
+	for {
+		var skipTo []byte
+		itr := appState.db.Iterator(start, nil)
+		for ; itr.Valid(); itr.Next() {
+			key := itr.Key()
+			// State tree is not app state, skip whole tree key range
+			if bytes.HasPrefix(key, stateTreeLeafKeyPrefix) {
+				skipTo = prefixEnd(stateTreeLeafKeyPrefix)
+				break
+			}
+			if bytes.HasPrefix(key, stateTreeNodeKeyPrefix) {
+				skipTo = prefixEnd(stateTreeNodeKeyPrefix)
+				break
+			}
+			if visitedCount == maxKeys {
+				nextCursor := append([]byte{}, key...)
+				itr.Close()
+				return nextCursor
+			}
+			visitedCount++
+			if !isVersionsKey(key) {
+				continue
+			}
+			versionsKey := string(key)
+			if _, written := appState.uncommittedVersionsState[versionsKey]; written {
+				continue
+			}
+			var keyVersions data.KeyVersions
+			err := proto.Unmarshal(itr.Value(), &keyVersions)
+			if err != nil || len(keyVersions.Versions) <= 1 {
+				continue
+			}
+			versions := keyVersions.Versions
+			latestKey := strings.TrimSuffix(versionsKey, "|versions") + "|" + strconv.FormatInt(versions[len(versions)-1], 10)
+			latestValue := appState.db.Get([]byte(latestKey))
+			if keyVersions.PrunedFirstVersion != 0 {
+				appState.uncommittedPrunedFirstVersion[versionsKey] = keyVersions.PrunedFirstVersion
+			}
+			if !appState.pruneKeyVersions(policy, isFinalVersion, versionsKey, versions, latestValue) {
+				delete(appState.uncommittedPrunedFirstVersion, versionsKey)
+			}
+		}
+		itr.Close()
+		if skipTo == nil {
+			return nil
+		}
+		start = skipTo
+	}
+}
+
+// pruneKeyVersions discards versions of key of versionsKey according to
+// policy. Returns false if no version is discarded.
+func (appState *AppState) pruneKeyVersions(policy *data.VersionPruningPolicy, isFinalVersion func(key string, value []byte) bool, versionsKey string, versions []int64, latestValue []byte) bool {
+	key := strings.TrimSuffix(versionsKey, "|versions")
+	pruneCount := 0
+	if isFinalVersion != nil && isFinalVersion(key, latestValue) {
+		pruneCount = len(versions) - 1
+	} else if policy.KeepLastVersions > 0 || policy.KeepRecentBlocks > 0 {
+		for pruneCount < len(versions)-1 {
+			if policy.KeepLastVersions > 0 && int64(len(versions)-pruneCount) <= policy.KeepLastVersions {
+				break
+			}
+			if policy.KeepRecentBlocks > 0 && versions[pruneCount] > appState.CurrentBlockHeight-policy.KeepRecentBlocks {
+				break
+			}
+			pruneCount++
+		}
+	}
+	if pruneCount == 0 {
+		return false
+	}
+
+	if appState.uncommittedPrunedFirstVersion[versionsKey] == 0 {
+		appState.uncommittedPrunedFirstVersion[versionsKey] = versions[0]
+	}
+	for _, version := range versions[:pruneCount] {
+		appState.uncommittedState[key+"|"+strconv.FormatInt(version, 10)] = nil
+	}
+	appState.uncommittedVersionsState[versionsKey] = append([]int64{}, versions[pruneCount:]...)
+	return true
+}
+
+// IterateCommitted calls fn for each key/value of committed state with key
+// in range [start, end) in key order. Iteration stops when fn returns false.
+// Keys passed to fn are recorded as read while recording so that query
+// proofs cover them (proofs do not show that range has no other keys).
+func (appState *AppState) IterateCommitted(start, end []byte, fn func(key, value []byte) bool) {
+	itr := appState.db.Iterator(start, end)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		if appState.recordReads {
+			appState.recordCommittedRead(append([]byte{}, itr.Key()...))
+		}
+		if !fn(itr.Key(), itr.Value()) {
+			return
+		}
+	}
+}
+
+// SetCommittedReadHeight sets height at which versioned keys are read from
+// committed state by Get and Has (0 means latest)
+func (appState *AppState) SetCommittedReadHeight(height int64) {
+	appState.committedReadHeight = height
+}
+
+// Hash returns root hash of committed state
+func (appState *AppState) Hash() []byte {
+	return appState.tree.Hash()
+}
+
+// StartRecordCommittedReads starts recording keys read from committed state
+func (appState *AppState) StartRecordCommittedReads() {
+	appState.committedReadKeys = make([][]byte, 0)
+	appState.committedReadKeySet = make(map[string]bool)
+	appState.recordReads = true
+}
+
+// StopRecordCommittedReads stops recording and returns keys read from
+// committed state (without duplicates, in order of first read)
+func (appState *AppState) StopRecordCommittedReads() [][]byte {
+	keys := appState.committedReadKeys
+	appState.committedReadKeys = nil
+	appState.committedReadKeySet = nil
+	appState.recordReads = false
+	return keys
+}
+
+// Prove returns proof of key in committed state
+// (inclusion proof if key exists, absence proof otherwise)
+func (appState *AppState) Prove(key []byte) *merkle.Proof {
+	return &merkle.Proof{Ops: appState.tree.Prove(key)}
+}
+
+// ProveKeys returns raw values of keys in committed state and their proofs
+// as one proof. Each key contributes stateTreeDepth+1 consecutive proof
+// operators in the same order as keys.
+func (appState *AppState) ProveKeys(keys [][]byte) ([]*protoProof.ProvenStateItem, *merkle.Proof) {
+	items := make([]*protoProof.ProvenStateItem, 0, len(keys))
+	var proof merkle.Proof
+	for _, key := range keys {
+		value := appState.db.Get(key)
+		items = append(items, &protoProof.ProvenStateItem{
+			Key:   key,
+			Value: value,
+			Exist: value != nil,
+		})
+		proof.Ops = append(proof.Ops, appState.tree.Prove(key)...)
+	}
+	return items, &proof
+}
+
+func (appState *AppState) dbGetCommitted(key []byte) []byte {
+	if appState.recordReads {
+		appState.recordCommittedRead(key)
+	}
+	return appState.db.Get(key)
+}
+
+func (appState *AppState) recordCommittedRead(key []byte) {
+	if appState.committedReadKeySet[string(key)] {
+		return
+	}
+	appState.committedReadKeySet[string(key)] = true
+	appState.committedReadKeys = append(appState.committedReadKeys, key)
 }
@@ -34,54 +34,76 @@ import (
 	data "github.com/ndidplatform/smart-contract/v4/protos/data"
 )
 
-func (app *ABCIApplication) getTokenPriceByFunc(fnName string, committedState bool) float64 {
+// getTokenPriceByFunc returns price of function for node at block height in
+// minor unit. Price in price schedule of function takes precedence over
+// price set before price schedule was introduced.
+func (app *ABCIApplication) getTokenPriceByFunc(fnName string, nodeID string, height int64, committedState bool) int64 {
+	entry := app.getPriceFuncScheduleEntry(fnName, nodeID, height, committedState)
+	if entry != nil {
+		return entry.Price
+	}
+	return app.getLegacyTokenPriceByFunc(fnName, committedState)
+}
+
+// getLegacyTokenPriceByFunc returns price of function set before price
+// schedule was introduced in minor unit
+func (app *ABCIApplication) getLegacyTokenPriceByFunc(fnName string, committedState bool) int64 {
+	decimals := app.getTokenDecimals(committedState)
 	key := tokenPriceFuncKeyPrefix + keySeparator + fnName
 	value, _ := app.state.Get([]byte(key), committedState)
 	if value == nil {
 		// if not set price of Function --> return price=1
-		return 1.0
+		return tokenDecimalsScale(decimals).Int64()
 	}
 	var tokenPrice data.TokenPrice
 	err := proto.Unmarshal(value, &tokenPrice)
 	if err != nil {
-		return 1.0
+		return tokenDecimalsScale(decimals).Int64()
 	}
-	return tokenPrice.Price
+	return tokenPriceFromState(&tokenPrice, decimals)
 }
 
-func (app *ABCIApplication) setTokenPriceByFunc(fnName string, price float64) error {
-	key := tokenPriceFuncKeyPrefix + keySeparator + fnName
-	var tokenPrice data.TokenPrice
-	tokenPrice.Price = price
-	value, err := utils.ProtoDeterministicMarshal(&tokenPrice)
-	if err != nil {
-		return err
+// tokenPriceFromState returns price in minor unit. Legacy price in token
+// is converted with current decimals.
+func tokenPriceFromState(tokenPrice *data.TokenPrice, decimals uint32) int64 {
+	if tokenPrice.MinorPrice == 0 && tokenPrice.Price != 0 {
+		return tokenAmountFromLegacy(tokenPrice.Price, decimals)
 	}
-	app.state.Set([]byte(key), []byte(value))
-	return nil
+	return tokenPrice.MinorPrice
 }
 
 func (app *ABCIApplication) createTokenAccount(nodeID string) {
 	key := tokenKeyPrefix + keySeparator + nodeID
 	var token data.Token
-	token.Amount = 0
+	token.MinorAmount = 0
 	value, _ := utils.ProtoDeterministicMarshal(&token)
 	app.state.Set([]byte(key), []byte(value))
 }
 
-func (app *ABCIApplication) setToken(nodeID string, amount float64) error {
+// getTokenAccount returns token account of node with amount in minor unit.
+// Legacy amount in token is converted with current decimals and is
+// replaced by minor amount when account is saved.
+func (app *ABCIApplication) getTokenAccount(nodeID string, committedState bool) (*data.Token, error) {
 	key := tokenKeyPrefix + keySeparator + nodeID
-	value, _ := app.state.Get([]byte(key), false)
+	value, _ := app.state.Get([]byte(key), committedState)
 	if value == nil {
-		return errors.New("token account not found")
+		return nil, errors.New("token account not found")
 	}
 	var token data.Token
 	err := proto.Unmarshal(value, &token)
 	if err != nil {
-		return errors.New("token account not found")
+		return nil, errors.New("token account not found")
+	}
+	if token.MinorAmount == 0 && token.Amount != 0 {
+		token.MinorAmount = tokenAmountFromLegacy(token.Amount, app.getTokenDecimals(committedState))
 	}
-	token.Amount = amount
-	value, err = utils.ProtoDeterministicMarshal(&token)
+	token.Amount = 0
+	return &token, nil
+}
+
+func (app *ABCIApplication) setTokenAccount(nodeID string, token *data.Token) error {
+	key := tokenKeyPrefix + keySeparator + nodeID
+	value, err := utils.ProtoDeterministicMarshal(token)
 	if err != nil {
 		return errors.New("token account not found")
 	}
@@ -89,6 +111,25 @@ func (app *ABCIApplication) setToken(nodeID string, amount float64) error {
 	return nil
 }
 
+func (app *ABCIApplication) setToken(nodeID string, amount int64, ref tokenLedgerRef) error {
+	token, err := app.getTokenAccount(nodeID, false)
+	if err != nil {
+		return err
+	}
+	change, err := addTokenAmounts(amount, -token.MinorAmount)
+	if err != nil {
+		return err
+	}
+	status := tokenBalanceStatus(token)
+	token.MinorAmount = amount
+	err = app.setTokenAccount(nodeID, token)
+	if err != nil {
+		return err
+	}
+	app.addTokenBalanceStatusEvent(nodeID, status, token, 0)
+	return app.addTokenLedgerEntry(nodeID, change, token.MinorAmount, ref)
+}
+
 func (app *ABCIApplication) setPriceFunc(param string, nodeID string) types.ResponseDeliverTx {
 	app.logger.Infof("SetPriceFunc, Parameter: %s", param)
 	var funcParam SetPriceFuncParam
@@ -96,9 +137,48 @@ func (app *ABCIApplication) setPriceFunc(param string, nodeID string) types.Resp
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
-	err = app.setTokenPriceByFunc(funcParam.Func, funcParam.Price)
+	var entry data.PriceFuncScheduleEntry
+	entry.EffectiveBlockHeight = funcParam.EffectiveBlockHeight
+	if entry.EffectiveBlockHeight == 0 {
+		entry.EffectiveBlockHeight = app.state.CurrentBlockHeight
+	}
+	if entry.EffectiveBlockHeight < app.state.CurrentBlockHeight {
+		return app.ReturnDeliverTxLog(code.InvalidEffectiveBlockHeight, "Effective block height must be greater than or equal to current block height", "")
+	}
+	if funcParam.Role != "" && funcParam.NodeID != "" {
+		return app.ReturnDeliverTxLog(code.InvalidPriceFuncOverride, "Price can be overridden for either role or node", "")
+	}
+	if funcParam.Role != "" && !isPriceFuncOverrideRole[funcParam.Role] {
+		return app.ReturnDeliverTxLog(code.WrongRole, "Wrong Role", "")
+	}
+	if funcParam.NodeID != "" {
+		nodeDetail, err := app.getNodeDetail(funcParam.NodeID, false)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+		}
+		if nodeDetail == nil {
+			return app.ReturnDeliverTxLog(code.NodeIDNotFound, "Node ID not found", "")
+		}
+	}
+	entry.Role = funcParam.Role
+	entry.NodeId = funcParam.NodeID
+	if funcParam.RemoveOverride {
+		if funcParam.Role == "" && funcParam.NodeID == "" {
+			return app.ReturnDeliverTxLog(code.InvalidPriceFuncOverride, "Role or node ID is required for removing price override", "")
+		}
+		entry.RemoveOverride = true
+	} else {
+		entry.Price, err = parseTokenAmount(funcParam.Price, app.getTokenDecimals(false))
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.InvalidTokenAmount, err.Error(), "")
+		}
+		if entry.Price < 0 {
+			return app.ReturnDeliverTxLog(code.PriceMustBeGreaterOrEqualToZero, "Price must be greater than or equal to zero", "")
+		}
+	}
+	err = app.addPriceFuncScheduleEntry(funcParam.Func, &entry)
 	if err != nil {
-		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
@@ -110,9 +190,17 @@ func (app *ABCIApplication) getPriceFunc(param string, committedState bool) type
 	if err != nil {
 		return app.ReturnQuery(nil, err.Error(), app.state.Height)
 	}
-	price := app.getTokenPriceByFunc(funcParam.Func, committedState)
-	var res = GetPriceFuncResult{
-		price,
+	height := funcParam.BlockHeight
+	if height == 0 {
+		height = app.state.Height
+	}
+	var res GetPriceFuncResult
+	entry := app.getPriceFuncScheduleEntry(funcParam.Func, funcParam.NodeID, height, committedState)
+	if entry != nil {
+		res.Price = formatTokenAmount(entry.Price, app.getTokenDecimals(committedState))
+		res.EffectiveBlockHeight = entry.EffectiveBlockHeight
+	} else {
+		res.Price = formatTokenAmount(app.getLegacyTokenPriceByFunc(funcParam.Func, committedState), app.getTokenDecimals(committedState))
 	}
 	value, err := json.Marshal(res)
 	if err != nil {
@@ -121,75 +209,73 @@ func (app *ABCIApplication) getPriceFunc(param string, committedState bool) type
 	return app.ReturnQuery(value, "success", app.state.Height)
 }
 
-func (app *ABCIApplication) addToken(nodeID string, amount float64) error {
-	key := tokenKeyPrefix + keySeparator + nodeID
-	value, _ := app.state.Get([]byte(key), false)
-	if value == nil {
-		return errors.New("token account not found")
+func (app *ABCIApplication) addToken(nodeID string, amount int64, ref tokenLedgerRef) error {
+	token, err := app.getTokenAccount(nodeID, false)
+	if err != nil {
+		return err
 	}
-	var token data.Token
-	err := proto.Unmarshal(value, &token)
+	token.MinorAmount, err = addTokenAmounts(token.MinorAmount, amount)
 	if err != nil {
-		return errors.New("token account not found")
+		return err
 	}
-	token.Amount = token.Amount + amount
-	value, err = utils.ProtoDeterministicMarshal(&token)
+	err = app.setTokenAccount(nodeID, token)
 	if err != nil {
-		return errors.New("token account not found")
+		return err
 	}
-	app.state.Set([]byte(key), []byte(value))
-	return nil
+	return app.addTokenLedgerEntry(nodeID, amount, token.MinorAmount, ref)
 }
 
 func (app *ABCIApplication) checkTokenAccount(nodeID string) bool {
-	key := tokenKeyPrefix + keySeparator + nodeID
-	value, _ := app.state.Get([]byte(key), false)
-	if value == nil {
-		return false
-	}
-	var token data.Token
-	err := proto.Unmarshal(value, &token)
-	if err != nil {
-		return false
-	}
-	return true
+	_, err := app.getTokenAccount(nodeID, false)
+	return err == nil
 }
 
-func (app *ABCIApplication) reduceToken(nodeID string, amount float64) (errorCode uint32, errorLog string) {
-	key := tokenKeyPrefix + keySeparator + nodeID
-	value, _ := app.state.Get([]byte(key), false)
-	if value == nil {
-		return code.TokenAccountNotFound, "token account not found"
-	}
-	var token data.Token
-	err := proto.Unmarshal(value, &token)
+func (app *ABCIApplication) reduceToken(nodeID string, amount int64, ref tokenLedgerRef) (errorCode uint32, errorLog string) {
+	token, err := app.getTokenAccount(nodeID, false)
 	if err != nil {
 		return code.TokenAccountNotFound, "token account not found"
 	}
-	if amount > token.Amount {
+	// Balance can be negative down to credit limit of token account
+	balance, err := addTokenAmounts(token.MinorAmount, -amount)
+	if err != nil || balance < -token.CreditLimit {
+		app.addTokenOverLimitEvent(nodeID, token, amount)
 		return code.TokenNotEnough, "token not enough"
 	}
-	token.Amount = token.Amount - amount
-	value, err = utils.ProtoDeterministicMarshal(&token)
+	status := tokenBalanceStatus(token)
+	token.MinorAmount = balance
+	err = app.setTokenAccount(nodeID, token)
 	if err != nil {
 		return code.TokenAccountNotFound, "token account not found"
 	}
-	app.state.Set([]byte(key), []byte(value))
+	app.addTokenBalanceStatusEvent(nodeID, status, token, amount)
+	err = app.addTokenLedgerEntry(nodeID, -amount, token.MinorAmount, ref)
+	if err != nil {
+		return code.MarshalError, err.Error()
+	}
 	return code.OK, ""
 }
 
-func (app *ABCIApplication) getToken(nodeID string, committedState bool) (float64, error) {
-	key := tokenKeyPrefix + keySeparator + nodeID
-	value, _ := app.state.Get([]byte(key), committedState)
-	if value == nil {
-		return 0, errors.New("token account not found")
+// getToken returns token of node in minor unit
+func (app *ABCIApplication) getToken(nodeID string, committedState bool) (int64, error) {
+	token, err := app.getTokenAccount(nodeID, committedState)
+	if err != nil {
+		return 0, err
 	}
-	var token data.Token
-	err := proto.Unmarshal(value, &token)
+	return token.MinorAmount, nil
+}
+
+// getAvailableToken returns token of node including its credit limit in
+// minor unit
+func (app *ABCIApplication) getAvailableToken(nodeID string, committedState bool) (int64, error) {
+	token, err := app.getTokenAccount(nodeID, committedState)
+	if err != nil {
+		return 0, err
+	}
+	available, err := addTokenAmounts(token.MinorAmount, token.CreditLimit)
 	if err != nil {
-		return 0, errors.New("token account not found")
+		return token.MinorAmount, nil
 	}
-	return token.Amount, nil
+	return available, nil
 }
 
 func (app *ABCIApplication) setNodeToken(param string, nodeID string) types.ResponseDeliverTx {
@@ -199,15 +285,23 @@ func (app *ABCIApplication) setNodeToken(param string, nodeID string) types.Resp
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
+	amount, err := parseTokenAmount(funcParam.Amount, app.getTokenDecimals(false))
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.InvalidTokenAmount, err.Error(), "")
+	}
 	// Validate parameter
-	if funcParam.Amount < 0 {
+	if amount < 0 {
 		return app.ReturnDeliverTxLog(code.AmountMustBeGreaterOrEqualToZero, "Amount must be greater than or equal to zero", "")
 	}
 	// Check token account
 	if !app.checkTokenAccount(funcParam.NodeID) {
 		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, "token account not found", "")
 	}
-	err = app.setToken(funcParam.NodeID, funcParam.Amount)
+	err = app.setToken(funcParam.NodeID, amount, tokenLedgerRef{
+		Method:       "SetNodeToken",
+		Reason:       tokenLedgerReasonAdjustment,
+		Counterparty: nodeID,
+	})
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, err.Error(), "")
 	}
@@ -221,15 +315,23 @@ func (app *ABCIApplication) addNodeToken(param string, nodeID string) types.Resp
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
+	amount, err := parseTokenAmount(funcParam.Amount, app.getTokenDecimals(false))
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.InvalidTokenAmount, err.Error(), "")
+	}
 	// Validate parameter
-	if funcParam.Amount < 0 {
+	if amount < 0 {
 		return app.ReturnDeliverTxLog(code.AmountMustBeGreaterOrEqualToZero, "Amount must be greater than or equal to zero", "")
 	}
 	// Check token account
 	if !app.checkTokenAccount(funcParam.NodeID) {
 		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, "token account not found", "")
 	}
-	err = app.addToken(funcParam.NodeID, funcParam.Amount)
+	err = app.addToken(funcParam.NodeID, amount, tokenLedgerRef{
+		Method:       "AddNodeToken",
+		Reason:       tokenLedgerReasonAdjustment,
+		Counterparty: nodeID,
+	})
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, err.Error(), "")
 	}
@@ -243,15 +345,23 @@ func (app *ABCIApplication) reduceNodeToken(param string, nodeID string) types.R
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
+	amount, err := parseTokenAmount(funcParam.Amount, app.getTokenDecimals(false))
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.InvalidTokenAmount, err.Error(), "")
+	}
 	// Validate parameter
-	if funcParam.Amount < 0 {
+	if amount < 0 {
 		return app.ReturnDeliverTxLog(code.AmountMustBeGreaterOrEqualToZero, "Amount must be greater than or equal to zero", "")
 	}
 	// Check token account
 	if !app.checkTokenAccount(funcParam.NodeID) {
 		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, "token account not found", "")
 	}
-	errCode, errLog := app.reduceToken(funcParam.NodeID, funcParam.Amount)
+	errCode, errLog := app.reduceToken(funcParam.NodeID, amount, tokenLedgerRef{
+		Method:       "ReduceNodeToken",
+		Reason:       tokenLedgerReasonAdjustment,
+		Counterparty: nodeID,
+	})
 	if errCode != code.OK {
 		return app.ReturnDeliverTxLog(errCode, errLog, "")
 	}
@@ -265,12 +375,16 @@ func (app *ABCIApplication) getNodeToken(param string, committedState bool) type
 	if err != nil {
 		return app.ReturnQuery([]byte("{}"), err.Error(), app.state.Height)
 	}
-	tokenAmount, err := app.getToken(funcParam.NodeID, committedState)
+	token, err := app.getTokenAccount(funcParam.NodeID, committedState)
 	if err != nil {
 		return app.ReturnQuery([]byte("{}"), "not found", app.state.Height)
 	}
+	decimals := app.getTokenDecimals(committedState)
 	var res = GetNodeTokenResult{
-		tokenAmount,
+		formatTokenAmount(token.MinorAmount, decimals),
+		formatTokenAmount(token.CreditLimit, decimals),
+		formatTokenAmount(token.LowBalanceThreshold, decimals),
+		tokenBalanceStatus(token),
 	}
 	value, err := json.Marshal(res)
 	if err != nil {
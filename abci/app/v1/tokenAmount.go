@@ -0,0 +1,206 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+)
+
+// Token amounts are stored as integers in minor unit (10^-decimals token).
+// Decimals is set once by InitNDID.
+const (
+	defaultTokenDecimals = 6
+	maxTokenDecimals     = 9
+)
+
+var decimalAmountPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?([eE][+-]?[0-9]{1,3})?$`)
+
+// DecimalAmount is token amount in JSON parameters and results. It is
+// unmarshaled from JSON number or decimal string and marshaled as JSON
+// number with exact decimal digits.
+type DecimalAmount string
+
+// UnmarshalJSON accepts JSON number or string of decimal number
+func (amount *DecimalAmount) UnmarshalJSON(value []byte) error {
+	if string(value) == "null" {
+		return nil
+	}
+	var text string
+	if len(value) > 0 && value[0] == '"' {
+		err := json.Unmarshal(value, &text)
+		if err != nil {
+			return err
+		}
+	} else {
+		var number json.Number
+		err := json.Unmarshal(value, &number)
+		if err != nil {
+			return err
+		}
+		text = string(number)
+	}
+	if !decimalAmountPattern.MatchString(text) {
+		return fmt.Errorf("invalid token amount: %s", text)
+	}
+	*amount = DecimalAmount(text)
+	return nil
+}
+
+// MarshalJSON returns amount as JSON number
+func (amount DecimalAmount) MarshalJSON() ([]byte, error) {
+	if amount == "" {
+		return []byte("0"), nil
+	}
+	return []byte(amount), nil
+}
+
+func tokenDecimalsScale(decimals uint32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+}
+
+// parseTokenAmount converts decimal amount to minor unit. Amount with more
+// decimal places than decimals or out of int64 range is rejected.
+func parseTokenAmount(amount DecimalAmount, decimals uint32) (int64, error) {
+	if amount == "" {
+		return 0, nil
+	}
+	if !decimalAmountPattern.MatchString(string(amount)) {
+		return 0, fmt.Errorf("invalid token amount: %s", amount)
+	}
+	value, ok := new(big.Rat).SetString(string(amount))
+	if !ok {
+		return 0, fmt.Errorf("invalid token amount: %s", amount)
+	}
+	value.Mul(value, new(big.Rat).SetInt(tokenDecimalsScale(decimals)))
+	if !value.IsInt() {
+		return 0, fmt.Errorf("token amount %s has more than %d decimal places", amount, decimals)
+	}
+	if !value.Num().IsInt64() {
+		return 0, fmt.Errorf("token amount %s is out of range", amount)
+	}
+	return value.Num().Int64(), nil
+}
+
+// formatTokenAmount converts amount in minor unit to decimal amount
+// without trailing zeros
+func formatTokenAmount(value int64, decimals uint32) DecimalAmount {
+	digits := new(big.Int).Abs(big.NewInt(value)).String()
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+	integerPart := digits[:len(digits)-int(decimals)]
+	fractionPart := strings.TrimRight(digits[len(digits)-int(decimals):], "0")
+	text := integerPart
+	if fractionPart != "" {
+		text += "." + fractionPart
+	}
+	if value < 0 {
+		text = "-" + text
+	}
+	return DecimalAmount(text)
+}
+
+// tokenAmountFromLegacy converts legacy floating point amount in token to
+// minor unit. Shortest decimal representation of amount is rounded half away
+// from zero so every node gets the same result.
+func tokenAmountFromLegacy(amount float64, decimals uint32) int64 {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return 0
+	}
+	value, ok := new(big.Rat).SetString(strconv.FormatFloat(amount, 'f', -1, 64))
+	if !ok {
+		return 0
+	}
+	value.Mul(value, new(big.Rat).SetInt(tokenDecimalsScale(decimals)))
+	numerator := new(big.Int).Abs(value.Num())
+	quotient, remainder := new(big.Int).QuoRem(numerator, value.Denom(), new(big.Int))
+	if new(big.Int).Mul(remainder, big.NewInt(2)).Cmp(value.Denom()) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if !quotient.IsInt64() {
+		if amount < 0 {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	if amount < 0 {
+		return -quotient.Int64()
+	}
+	return quotient.Int64()
+}
+
+// addTokenAmounts returns sum of amounts in minor unit or error on overflow
+func addTokenAmounts(a int64, b int64) (int64, error) {
+	if (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b) {
+		return 0, errors.New("token amount is out of range")
+	}
+	return a + b, nil
+}
+
+// mulTokenAmount returns amount in minor unit multiplied by count or error on overflow
+func mulTokenAmount(amount int64, count int64) (int64, error) {
+	if amount == 0 || count == 0 {
+		return 0, nil
+	}
+	result := amount * count
+	if result/count != amount {
+		return 0, errors.New("token amount is out of range")
+	}
+	return result, nil
+}
+
+func (app *ABCIApplication) getTokenDecimals(committedState bool) uint32 {
+	value, _ := app.state.Get(tokenDecimalsKeyBytes, committedState)
+	if value == nil {
+		return defaultTokenDecimals
+	}
+	var decimals data.TokenDecimals
+	err := proto.Unmarshal(value, &decimals)
+	if err != nil {
+		return defaultTokenDecimals
+	}
+	return decimals.Decimals
+}
+
+func (app *ABCIApplication) getTokenDecimalsQuery(param string) types.ResponseQuery {
+	app.logger.Infof("GetTokenDecimals, Parameter: %s", param)
+	var result GetTokenDecimalsResult
+	result.Decimals = app.getTokenDecimals(true)
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(value, "success", app.state.Height)
+}
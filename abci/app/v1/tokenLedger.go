@@ -0,0 +1,158 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/utils"
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+)
+
+// Reason of token ledger entry
+const (
+	tokenLedgerReasonFee        = "fee"
+	tokenLedgerReasonEscrow     = "escrow"
+	tokenLedgerReasonRelease    = "release"
+	tokenLedgerReasonRefund     = "refund"
+	tokenLedgerReasonTransfer   = "transfer"
+	tokenLedgerReasonAdjustment = "adjustment"
+)
+
+const (
+	tokenStatementDefaultLimit = 100
+	tokenStatementMaxLimit     = 1000
+)
+
+// tokenLedgerRef describes what token of node is changed for
+type tokenLedgerRef struct {
+	Method       string
+	Reason       string
+	RequestID    string
+	Counterparty string
+}
+
+// tokenLedgerHeightKey returns key prefix of token ledger entries of node in
+// block. Block height is zero-padded so keys of node are sorted by height.
+func tokenLedgerHeightKey(nodeID string, height int64) []byte {
+	return []byte(tokenLedgerKeyPrefix + keySeparator + nodeID + keySeparator + fmt.Sprintf("%020d", height))
+}
+
+// tokenLedgerKey returns key of token ledger entry of node. Index of entry in
+// block is zero-padded so keys of node are sorted in order of changes.
+func tokenLedgerKey(nodeID string, height int64, index int64) []byte {
+	return append(tokenLedgerHeightKey(nodeID, height), []byte(keySeparator+fmt.Sprintf("%010d", index))...)
+}
+
+// addTokenLedgerEntry records change of token of node in current block.
+// Amount and balance are in minor unit.
+func (app *ABCIApplication) addTokenLedgerEntry(nodeID string, amount int64, balance int64, ref tokenLedgerRef) error {
+	if amount == 0 {
+		return nil
+	}
+	value, err := utils.ProtoDeterministicMarshal(&data.Report{
+		Method:       ref.Method,
+		Amount:       amount,
+		RequestId:    ref.RequestID,
+		BlockHeight:  app.state.CurrentBlockHeight,
+		Reason:       ref.Reason,
+		Counterparty: ref.Counterparty,
+		Balance:      balance,
+	})
+	if err != nil {
+		return err
+	}
+	app.state.Set(tokenLedgerKey(nodeID, app.state.CurrentBlockHeight, app.tokenLedgerIndex), value)
+	app.tokenLedgerIndex++
+	app.indexBillingPeriod()
+	return nil
+}
+
+// iterateTokenLedger calls fn with every token ledger entry of node from block
+// height (inclusive) to block height (exclusive) in order of changes
+func (appState *AppState) iterateTokenLedger(nodeID string, fromHeight int64, toHeight int64, fn func(entry *data.Report)) (err error) {
+	start := tokenLedgerHeightKey(nodeID, fromHeight)
+	end := tokenLedgerHeightKey(nodeID, toHeight)
+	appState.IterateCommitted(start, end, func(key, value []byte) bool {
+		var entry data.Report
+		err = proto.Unmarshal(value, &entry)
+		if err != nil {
+			return false
+		}
+		fn(&entry)
+		return true
+	})
+	return err
+}
+
+func (app *ABCIApplication) getTokenStatement(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetTokenStatement, Parameter: %s", param)
+	var funcParam GetTokenStatementParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	limit := funcParam.Limit
+	if limit <= 0 {
+		limit = tokenStatementDefaultLimit
+	}
+	if limit > tokenStatementMaxLimit {
+		limit = tokenStatementMaxLimit
+	}
+	// Entries are read up to query height
+	toBlockHeight := funcParam.ToBlockHeight
+	if toBlockHeight <= 0 || toBlockHeight > height {
+		toBlockHeight = height
+	}
+	decimals := app.getTokenDecimals(true)
+	var result GetTokenStatementResult
+	result.Entries = make([]TokenLedgerEntry, 0)
+	if funcParam.FromBlockHeight <= toBlockHeight {
+		err = app.state.iterateTokenLedger(funcParam.NodeID, funcParam.FromBlockHeight, toBlockHeight+1, func(entry *data.Report) {
+			if result.TotalCount >= funcParam.Offset && len(result.Entries) < limit {
+				result.Entries = append(result.Entries, TokenLedgerEntry{
+					BlockHeight:  entry.BlockHeight,
+					Method:       entry.Method,
+					Reason:       entry.Reason,
+					RequestID:    entry.RequestId,
+					Counterparty: entry.Counterparty,
+					Amount:       formatTokenAmount(entry.Amount, decimals),
+					Balance:      formatTokenAmount(entry.Balance, decimals),
+				})
+			}
+			result.TotalCount++
+		})
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), app.state.Height)
+		}
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(returnValue, "success", app.state.Height)
+}
@@ -0,0 +1,234 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/abci/utils"
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+)
+
+func (app *ABCIApplication) checkTxTransferToken(param string, nodeID string) types.ResponseCheckTx {
+	nodeDetail, err := app.getNodeDetail(nodeID, true)
+	if err != nil {
+		return ReturnCheckTx(code.UnmarshalError, err.Error())
+	}
+	if nodeDetail == nil ||
+		(nodeDetail.Role != "RP" &&
+			nodeDetail.Role != "IdP" &&
+			nodeDetail.Role != "AS" &&
+			nodeDetail.Role != "Proxy") {
+		return ReturnCheckTx(code.NoPermissionForTransferToken, "This node does not have permission to transfer token")
+	}
+	return ReturnCheckTx(code.OK, "")
+}
+
+// getTokenTransferPolicy returns token transfer policy. Transfer is denied
+// between any nodes until NDID sets the policy.
+func (app *ABCIApplication) getTokenTransferPolicy(committedState bool) (*data.TokenTransferPolicy, error) {
+	var policy data.TokenTransferPolicy
+	value, _ := app.state.Get(tokenTransferPolicyKeyBytes, committedState)
+	if value == nil {
+		return &data.TokenTransferPolicy{Enabled: true}, nil
+	}
+	err := proto.Unmarshal(value, &policy)
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (app *ABCIApplication) setTokenTransferPolicy(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("SetTokenTransferPolicy, Parameter: %s", param)
+	var funcParam SetTokenTransferPolicyParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	var policy data.TokenTransferPolicy
+	policy.Enabled = funcParam.Enabled
+	policy.AllowSameProxy = funcParam.AllowSameProxy
+	// Group ID must be unique and node can be in only one group
+	groupIDs := make(map[string]bool)
+	nodeIDs := make(map[string]bool)
+	for _, group := range funcParam.OrganizationGroups {
+		if group.GroupID == "" || groupIDs[group.GroupID] {
+			return app.ReturnDeliverTxLog(code.InvalidOrganizationGroup, "Organization group ID is empty or duplicate", "")
+		}
+		groupIDs[group.GroupID] = true
+		for _, groupNodeID := range group.NodeIDList {
+			if nodeIDs[groupNodeID] {
+				return app.ReturnDeliverTxLog(code.InvalidOrganizationGroup, "Node ID is in more than one organization group", "")
+			}
+			nodeIDs[groupNodeID] = true
+		}
+		policy.OrganizationGroups = append(policy.OrganizationGroups, &data.OrganizationGroup{
+			GroupId:    group.GroupID,
+			NodeIdList: group.NodeIDList,
+		})
+	}
+	value, err := utils.ProtoDeterministicMarshal(&policy)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set(tokenTransferPolicyKeyBytes, value)
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+// isTokenTransferAllowed checks transfer between nodes against token transfer
+// policy. Nodes are in the same proxy when both are behind the same proxy
+// node or one of them is the proxy node of the other.
+func isTokenTransferAllowed(policy *data.TokenTransferPolicy, fromNodeID string, fromNode *data.NodeDetail, toNodeID string, toNode *data.NodeDetail) bool {
+	if !policy.Enabled {
+		return true
+	}
+	if policy.AllowSameProxy {
+		if fromNode.ProxyNodeId != "" && fromNode.ProxyNodeId == toNode.ProxyNodeId {
+			return true
+		}
+		if fromNode.ProxyNodeId == toNodeID || toNode.ProxyNodeId == fromNodeID {
+			return true
+		}
+	}
+	for _, group := range policy.OrganizationGroups {
+		var hasFrom, hasTo bool
+		for _, groupNodeID := range group.NodeIdList {
+			if groupNodeID == fromNodeID {
+				hasFrom = true
+			}
+			if groupNodeID == toNodeID {
+				hasTo = true
+			}
+		}
+		if hasFrom && hasTo {
+			return true
+		}
+	}
+	return false
+}
+
+func (app *ABCIApplication) transferToken(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("TransferToken, Parameter: %s", param)
+	var funcParam TransferTokenParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	amount, err := parseTokenAmount(funcParam.Amount, app.getTokenDecimals(false))
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.InvalidTokenAmount, err.Error(), "")
+	}
+	if amount <= 0 {
+		return app.ReturnDeliverTxLog(code.AmountMustBeGreaterThanZero, "Amount must be greater than 0", "")
+	}
+	if funcParam.ToNodeID == nodeID {
+		return app.ReturnDeliverTxLog(code.CannotTransferTokenToItself, "Cannot transfer token to itself", "")
+	}
+	fromNode, err := app.getNodeDetail(nodeID, false)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if fromNode == nil {
+		return app.ReturnDeliverTxLog(code.NodeIDNotFound, "Node ID not found", "")
+	}
+	toNode, err := app.getNodeDetail(funcParam.ToNodeID, false)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if toNode == nil {
+		return app.ReturnDeliverTxLog(code.NodeIDNotFound, "Node ID not found", "")
+	}
+	policy, err := app.getTokenTransferPolicy(false)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if !isTokenTransferAllowed(policy, nodeID, fromNode, funcParam.ToNodeID, toNode) {
+		return app.ReturnDeliverTxLog(code.TokenTransferIsNotAllowed, "Token transfer is not allowed by token transfer policy", "")
+	}
+	if !app.checkTokenAccount(funcParam.ToNodeID) {
+		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, "token account not found", "")
+	}
+	// Credit of token account cannot be transferred
+	balance, err := app.getToken(nodeID, false)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, err.Error(), "")
+	}
+	if amount > balance {
+		return app.ReturnDeliverTxLog(code.TokenNotEnough, "token not enough", "")
+	}
+	errCode, errLog := app.reduceToken(nodeID, amount, tokenLedgerRef{
+		Method:       "TransferToken",
+		Reason:       tokenLedgerReasonTransfer,
+		Counterparty: funcParam.ToNodeID,
+	})
+	if errCode != code.OK {
+		return app.ReturnDeliverTxLog(errCode, errLog, "")
+	}
+	err = app.addToken(funcParam.ToNodeID, amount, tokenLedgerRef{
+		Method:       "TransferToken",
+		Reason:       tokenLedgerReasonTransfer,
+		Counterparty: nodeID,
+	})
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, err.Error(), "")
+	}
+	result := app.ReturnDeliverTxLog(code.OK, "success", "")
+	result.Events = append(result.Events, types.Event{
+		Type: "did.token_transferred",
+		Attributes: []cmn.KVPair{
+			{Key: []byte("from_node_id"), Value: []byte(nodeID)},
+			{Key: []byte("to_node_id"), Value: []byte(funcParam.ToNodeID)},
+			{Key: []byte("amount"), Value: []byte(formatTokenAmount(amount, app.getTokenDecimals(false)))},
+		},
+	})
+	return result
+}
+
+func (app *ABCIApplication) getTokenTransferPolicyQuery(param string) types.ResponseQuery {
+	app.logger.Infof("GetTokenTransferPolicy, Parameter: %s", param)
+	policy, err := app.getTokenTransferPolicy(true)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	var result GetTokenTransferPolicyResult
+	result.Enabled = policy.Enabled
+	result.AllowSameProxy = policy.AllowSameProxy
+	result.OrganizationGroups = make([]OrganizationGroup, 0)
+	for _, group := range policy.OrganizationGroups {
+		result.OrganizationGroups = append(result.OrganizationGroups, OrganizationGroup{
+			GroupID:    group.GroupId,
+			NodeIDList: append(make([]string, 0), group.NodeIdList...),
+		})
+	}
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(value, "success", app.state.Height)
+}
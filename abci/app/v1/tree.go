@@ -0,0 +1,352 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+	"strconv"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// State tree
+//
+// Every key/value pair of the app state is a leaf of a fixed depth Merkle
+// trie built with Tendermint simple Merkle maps. The path of a key is the
+// first stateTreeDepth hex digits of SHA-256(key). A node at the bottom level
+// is the simple Merkle map root of (key, SHA-256(value)) of the keys with
+// the node path. Every other node is the simple Merkle map root of
+// (hex digit, SHA-256(child node hash)) of its non-empty children and the app
+// hash is the root node. Nodes are at most 16-way (except bottom nodes) so
+// updating a key only rehashes the nodes on its path.
+//
+// A proof of a key is stateTreeDepth+1 chained proof operators (bottom node
+// first) which can be verified against the app hash with NewProofRuntime()
+// using key path StateTreeKeyPath(key). Inclusion is proven with "simple:v"
+// operators only. Absence is proven with "ndid:absent" operators (see
+// proof.go) at the levels where the key path is missing.
+const stateTreeDepth = 5
+
+var (
+	stateTreeLeafKeyPrefix = []byte("StateTreeLeaf|")
+	stateTreeNodeKeyPrefix = []byte("StateTreeNode|")
+)
+
+type stateTree struct {
+	db   dbm.DB
+	root []byte
+}
+
+func newStateTree(db dbm.DB) *stateTree {
+	return &stateTree{
+		db:   db,
+		root: db.Get(stateTreeNodeKey(0, "")),
+	}
+}
+
+// stateTreeRebuildChunkSize is maximum number of keys added to the tree in
+// one batch when rebuilding
+const stateTreeRebuildChunkSize = 10000
+
+// rebuildStateTree builds state tree from every key of app state in db.
+// It is used on the first start after upgrading from a version without state
+// tree so that data written before upgrade is in the tree.
+func rebuildStateTree(db dbm.DB) *stateTree {
+	tree := newStateTree(db)
+	var start []byte
+	for {
+		changes := make(map[string][]byte, stateTreeRebuildChunkSize)
+		next := collectStateChanges(db, start, changes)
+		if len(changes) > 0 {
+			batch := db.NewBatch()
+			tree.Update(batch, changes)
+			batch.WriteSync()
+			batch.Close()
+		}
+		if next == nil {
+			return tree
+		}
+		start = next
+	}
+}
+
+// collectStateChanges adds at most stateTreeRebuildChunkSize app state keys
+// from start in key order to changes and returns the key to continue from
+// (nil when the end of state is reached). State tree keys are skipped.
+func collectStateChanges(db dbm.DB, start []byte, changes map[string][]byte) []byte {
+	for {
+		var skipTo []byte
+		itr := db.Iterator(start, nil)
+		for ; itr.Valid(); itr.Next() {
+			key := itr.Key()
+			if bytes.HasPrefix(key, stateTreeLeafKeyPrefix) {
+				skipTo = prefixEnd(stateTreeLeafKeyPrefix)
+				break
+			}
+			if bytes.HasPrefix(key, stateTreeNodeKeyPrefix) {
+				skipTo = prefixEnd(stateTreeNodeKeyPrefix)
+				break
+			}
+			if bytes.Equal(key, appStateMetadataKey) {
+				continue
+			}
+			if len(changes) == stateTreeRebuildChunkSize {
+				next := append([]byte{}, key...)
+				itr.Close()
+				return next
+			}
+			changes[string(key)] = append([]byte{}, itr.Value()...)
+		}
+		itr.Close()
+		if skipTo == nil {
+			return nil
+		}
+		start = skipTo
+	}
+}
+
+func isStateTreeKey(key []byte) bool {
+	return bytes.HasPrefix(key, stateTreeLeafKeyPrefix) || bytes.HasPrefix(key, stateTreeNodeKeyPrefix)
+}
+
+func stateTreePath(key []byte) string {
+	sum := tmhash.Sum(key)
+	return hex.EncodeToString(sum)[:stateTreeDepth]
+}
+
+func stateTreeLeafKey(path string, key []byte) []byte {
+	leafKey := make([]byte, 0, len(stateTreeLeafKeyPrefix)+len(path)+1+len(key))
+	leafKey = append(leafKey, stateTreeLeafKeyPrefix...)
+	leafKey = append(leafKey, path...)
+	leafKey = append(leafKey, keySeparator...)
+	leafKey = append(leafKey, key...)
+	return leafKey
+}
+
+// stateTreeNodeKey returns DB key of node at level (length of path)
+func stateTreeNodeKey(level int, path string) []byte {
+	nodeKey := make([]byte, 0, len(stateTreeNodeKeyPrefix)+2+len(path))
+	nodeKey = append(nodeKey, stateTreeNodeKeyPrefix...)
+	nodeKey = append(nodeKey, strconv.Itoa(level)...)
+	nodeKey = append(nodeKey, keySeparator...)
+	nodeKey = append(nodeKey, path...)
+	return nodeKey
+}
+
+// prefixEnd returns the exclusive upper bound of all keys having prefix
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xFF {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// Update writes changed leaves (nil value means deleted) and the resulting
+// node hashes to batch. Result does not depend on the order of changes.
+func (tree *stateTree) Update(batch dbm.Batch, changes map[string][]byte) {
+	changedNodes := make(map[string]map[string][]byte)
+	for key, value := range changes {
+		if isStateTreeKey([]byte(key)) {
+			panic("state key collides with state tree key prefix: " + key)
+		}
+		path := stateTreePath([]byte(key))
+		if changedNodes[path] == nil {
+			changedNodes[path] = make(map[string][]byte)
+		}
+		if value == nil {
+			changedNodes[path][key] = nil
+		} else {
+			changedNodes[path][key] = tmhash.Sum(value)
+		}
+	}
+
+	// Node hashes written in this update (nil means deleted)
+	// since batch is not readable before it is written
+	updatedNodes := make(map[string][]byte)
+	setNode := func(level int, path string, nodeHash []byte) {
+		nodeKey := stateTreeNodeKey(level, path)
+		updatedNodes[string(nodeKey)] = nodeHash
+		if nodeHash == nil {
+			batch.Delete(nodeKey)
+		} else {
+			batch.Set(nodeKey, nodeHash)
+		}
+	}
+
+	parents := make(map[string]bool)
+	for path, nodeChanges := range changedNodes {
+		leaves := tree.loadLeaves(path)
+		for key, valueHash := range nodeChanges {
+			leafKey := stateTreeLeafKey(path, []byte(key))
+			if valueHash == nil {
+				delete(leaves, key)
+				batch.Delete(leafKey)
+			} else {
+				leaves[key] = valueHash
+				batch.Set(leafKey, valueHash)
+			}
+		}
+		setNode(stateTreeDepth, path, simpleMapRoot(leaves))
+		parents[path[:stateTreeDepth-1]] = true
+	}
+
+	for level := stateTreeDepth - 1; level >= 0; level-- {
+		nextParents := make(map[string]bool)
+		for path := range parents {
+			setNode(level, path, simpleMapRoot(tree.loadChildren(level, path, updatedNodes)))
+			if level > 0 {
+				nextParents[path[:level-1]] = true
+			}
+		}
+		parents = nextParents
+	}
+	if root, updated := updatedNodes[string(stateTreeNodeKey(0, ""))]; updated {
+		tree.root = root
+	}
+}
+
+// Hash returns root hash of the tree
+func (tree *stateTree) Hash() []byte {
+	if tree.root == nil {
+		return simpleMapRoot(nil)
+	}
+	return tree.root
+}
+
+// Prove returns stateTreeDepth+1 proof operators of key in committed state
+// (bottom node first). Operators prove absence of key if it does not exist.
+func (tree *stateTree) Prove(key []byte) []merkle.ProofOp {
+	path := stateTreePath(key)
+	ops := make([]merkle.ProofOp, 0, stateTreeDepth+1)
+	ops = append(ops, simpleMapProofOp(tree.loadLeaves(path), string(key)))
+	for level := stateTreeDepth - 1; level >= 0; level-- {
+		children := tree.loadChildren(level, path[:level], nil)
+		ops = append(ops, simpleMapProofOp(children, path[level:level+1]))
+	}
+	return ops
+}
+
+// StateTreeKeyPath returns key path used to verify proof of key
+func StateTreeKeyPath(key []byte) string {
+	path := stateTreePath(key)
+	var keyPath merkle.KeyPath
+	for i := 0; i < stateTreeDepth; i++ {
+		keyPath = keyPath.AppendKey([]byte(path[i:i+1]), merkle.KeyEncodingURL)
+	}
+	keyPath = keyPath.AppendKey(key, merkle.KeyEncodingHex)
+	return keyPath.String()
+}
+
+func (tree *stateTree) loadLeaves(path string) map[string][]byte {
+	leaves := make(map[string][]byte)
+	prefix := stateTreeLeafKey(path, nil)
+	itr := tree.db.Iterator(prefix, prefixEnd(prefix))
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		leaves[string(itr.Key()[len(prefix):])] = itr.Value()
+	}
+	return leaves
+}
+
+// loadChildren returns simple Merkle map leaves (hex digit, SHA-256(child
+// node hash)) of node at level with path. Node hashes in updatedNodes take
+// precedence over DB.
+func (tree *stateTree) loadChildren(level int, path string, updatedNodes map[string][]byte) map[string][]byte {
+	childHashes := make(map[string][]byte)
+	prefix := stateTreeNodeKey(level+1, path)
+	itr := tree.db.Iterator(prefix, prefixEnd(prefix))
+	for ; itr.Valid(); itr.Next() {
+		childHashes[string(itr.Key()[len(prefix):])] = itr.Value()
+	}
+	itr.Close()
+	for digit := 0; digit < 16; digit++ {
+		childPath := path + strconv.FormatInt(int64(digit), 16)
+		childHash, updated := updatedNodes[string(stateTreeNodeKey(level+1, childPath))]
+		if !updated {
+			continue
+		}
+		if childHash == nil {
+			delete(childHashes, childPath[level:])
+		} else {
+			childHashes[childPath[level:]] = childHash
+		}
+	}
+	children := make(map[string][]byte, len(childHashes))
+	for digit, childHash := range childHashes {
+		children[digit] = tmhash.Sum(childHash)
+	}
+	return children
+}
+
+func simpleMapItems(leaves map[string][]byte) (keys []string, items [][]byte) {
+	keys = make([]string, 0, len(leaves))
+	for key := range leaves {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	items = make([][]byte, len(keys))
+	for i, key := range keys {
+		items[i] = merkle.KVPair{Key: []byte(key), Value: leaves[key]}.Bytes()
+	}
+	return keys, items
+}
+
+// simpleMapRoot computes root of a simple Merkle map which values are
+// already hashed. Returns nil for an empty map.
+func simpleMapRoot(leaves map[string][]byte) []byte {
+	_, items := simpleMapItems(leaves)
+	return merkle.SimpleHashFromByteSlices(items)
+}
+
+// simpleMapProofOp returns "simple:v" operator of key in a simple Merkle map
+// which values are already hashed or "ndid:absent" operator if key is not in
+// the map
+func simpleMapProofOp(leaves map[string][]byte, key string) merkle.ProofOp {
+	keys, items := simpleMapItems(leaves)
+	var proofs []*merkle.SimpleProof
+	if len(items) > 0 {
+		_, proofs = merkle.SimpleProofsFromByteSlices(items)
+	}
+	index := sort.SearchStrings(keys, key)
+	if index < len(keys) && keys[index] == key {
+		return merkle.NewSimpleValueOp([]byte(key), proofs[index]).ProofOp()
+	}
+	var op SimpleAbsenceOp
+	op.key = []byte(key)
+	if index > 0 {
+		op.Left = &SimpleMapNeighbor{Key: []byte(keys[index-1]), ValueHash: leaves[keys[index-1]], Proof: proofs[index-1]}
+	}
+	if index < len(keys) {
+		op.Right = &SimpleMapNeighbor{Key: []byte(keys[index]), ValueHash: leaves[keys[index]], Proof: proofs[index]}
+	}
+	return op.ProofOp()
+}
@@ -0,0 +1,282 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	protoParams "github.com/ndidplatform/smart-contract/v4/protos/params"
+	protoTm "github.com/ndidplatform/smart-contract/v4/protos/tendermint"
+)
+
+// paramsMessagePrefix is proto package prefix of typed parameter and result messages
+const paramsMessagePrefix = "ndid.params.v1."
+
+// isAssuranceLevelField is JSON names of IAL and AAL fields. They are decimal
+// strings in typed params and JSON numbers in JSON form of parameters.
+var isAssuranceLevelField = map[string]bool{
+	"ial":     true,
+	"aal":     true,
+	"min_ial": true,
+	"min_aal": true,
+	"max_ial": true,
+	"max_aal": true,
+}
+
+var errUnknownTypedParamsField = errors.New("typed params has unknown field")
+
+// decodeTypedTxParams decodes serialized TxParams of method to JSON form of parameters
+func decodeTypedTxParams(method string, typedParams []byte) (string, error) {
+	var txParams protoParams.TxParams
+	return decodeTypedParams(method, typedParams, &txParams)
+}
+
+// decodeTypedQueryParams decodes serialized QueryParams of method to JSON form of parameters
+func decodeTypedQueryParams(method string, typedParams []byte) (string, error) {
+	var queryParams protoParams.QueryParams
+	return decodeTypedParams(method, typedParams, &queryParams)
+}
+
+func decodeTypedParams(method string, typedParams []byte, wrapper proto.Message) (string, error) {
+	err := proto.Unmarshal(typedParams, wrapper)
+	if err != nil {
+		return "", err
+	}
+	if hasUnrecognizedFields(reflect.ValueOf(wrapper)) {
+		return "", errUnknownTypedParamsField
+	}
+	// Value of oneof field is a struct with the parameter message as its only field
+	oneof := reflect.ValueOf(wrapper).Elem().FieldByName("Params")
+	if oneof.IsNil() {
+		return "", errors.New("typed params is not set")
+	}
+	message, ok := oneof.Elem().Elem().Field(0).Interface().(proto.Message)
+	if !ok || reflect.ValueOf(message).IsNil() {
+		return "", errors.New("typed params is not set")
+	}
+	expectedName := paramsMessagePrefix + method + "Params"
+	if proto.MessageName(message) != expectedName {
+		return "", fmt.Errorf("typed params must be %s, got %s", expectedName, proto.MessageName(message))
+	}
+	paramJSON, err := json.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+	value, err := decodeJSONWithNumber(paramJSON)
+	if err != nil {
+		return "", err
+	}
+	value, err = assuranceLevelsToNumbers(value)
+	if err != nil {
+		return "", err
+	}
+	paramJSON, err = json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(paramJSON), nil
+}
+
+// assuranceLevelsToNumbers converts decimal strings of IAL and AAL fields
+// in decoded JSON value to JSON numbers
+func assuranceLevelsToNumbers(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		// Sort names for the same error on every node
+		names := make([]string, 0, len(value))
+		for name := range value {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			field := value[name]
+			if level, ok := field.(string); ok && isAssuranceLevelField[name] {
+				if !decimalAmountPattern.MatchString(level) {
+					return nil, fmt.Errorf("invalid %s: %s", name, level)
+				}
+				value[name] = json.Number(level)
+				continue
+			}
+			converted, err := assuranceLevelsToNumbers(field)
+			if err != nil {
+				return nil, err
+			}
+			value[name] = converted
+		}
+	case []interface{}:
+		for i := range value {
+			converted, err := assuranceLevelsToNumbers(value[i])
+			if err != nil {
+				return nil, err
+			}
+			value[i] = converted
+		}
+	}
+	return value, nil
+}
+
+// hasUnrecognizedFields reports whether proto message or any of its
+// nested messages has fields which are not in the schema
+func hasUnrecognizedFields(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return false
+		}
+		return hasUnrecognizedFields(value.Elem())
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return false
+		}
+		for i := 0; i < value.Len(); i++ {
+			if hasUnrecognizedFields(value.Index(i)) {
+				return true
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			name := value.Type().Field(i).Name
+			if name == "XXX_unrecognized" {
+				if value.Field(i).Len() > 0 {
+					return true
+				}
+				continue
+			}
+			if strings.HasPrefix(name, "XXX_") {
+				continue
+			}
+			if hasUnrecognizedFields(value.Field(i)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func decodeJSONWithNumber(value []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	var result interface{}
+	err := decoder.Decode(&result)
+	return result, err
+}
+
+// normalizeParamsJSON walks decoded JSON value along fields of typed params
+// message type. Token amounts and assurance levels are JSON numbers in JSON
+// form but are decimal strings in typed params, so numbers of string fields
+// are converted to strings. Field which is not in message is left as is.
+func normalizeParamsJSON(value interface{}, fieldType reflect.Type) interface{} {
+	switch fieldType.Kind() {
+	case reflect.Ptr:
+		object, ok := value.(map[string]interface{})
+		if !ok || fieldType.Elem().Kind() != reflect.Struct {
+			return value
+		}
+		fields := make(map[string]reflect.Type)
+		for i := 0; i < fieldType.Elem().NumField(); i++ {
+			field := fieldType.Elem().Field(i)
+			if strings.HasPrefix(field.Name, "XXX_") {
+				continue
+			}
+			// JSON field names are matched case-insensitively like encoding/json
+			fields[strings.ToLower(strings.Split(field.Tag.Get("json"), ",")[0])] = field.Type
+		}
+		for name := range object {
+			nestedType, ok := fields[strings.ToLower(name)]
+			if !ok {
+				continue
+			}
+			object[name] = normalizeParamsJSON(object[name], nestedType)
+		}
+	case reflect.Slice:
+		list, ok := value.([]interface{})
+		if !ok {
+			return value
+		}
+		for i := range list {
+			list[i] = normalizeParamsJSON(list[i], fieldType.Elem())
+		}
+	case reflect.String:
+		if number, ok := value.(json.Number); ok {
+			return number.String()
+		}
+	}
+	return value
+}
+
+// encodeTypedQueryResult encodes JSON form of query result of method to
+// serialized <Method>Result message. Result of method which has no typed
+// result message is returned as is.
+func encodeTypedQueryResult(method string, value []byte) ([]byte, error) {
+	messageType := proto.MessageType(paramsMessagePrefix + method + "Result")
+	if messageType == nil || len(value) == 0 {
+		return value, nil
+	}
+	result := reflect.New(messageType.Elem()).Interface().(proto.Message)
+	// List result is the only field of result message
+	if bytes.HasPrefix(bytes.TrimSpace(value), []byte("[")) {
+		fieldName := strings.Split(messageType.Elem().Field(0).Tag.Get("json"), ",")[0]
+		value = []byte(`{"` + fieldName + `":` + string(value) + `}`)
+	}
+	decoded, err := decodeJSONWithNumber(value)
+	if err != nil {
+		return nil, err
+	}
+	value, err = json.Marshal(normalizeParamsJSON(decoded, messageType))
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(value, result)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(result)
+}
+
+// getTxParams returns JSON form of parameters of tx and the data which is signed
+// by caller. Typed parameters are signed in their serialized form.
+func getTxParams(txObj *protoTm.Tx) (param string, signedParam string, retCode uint32, retLog string) {
+	if len(txObj.TypedParams) == 0 {
+		return txObj.Params, txObj.Params, code.OK, ""
+	}
+	if txObj.Params != "" {
+		return "", "", code.InvalidTypedParams, "Params and typed params cannot be both set"
+	}
+	param, err := decodeTypedTxParams(txObj.Method, txObj.TypedParams)
+	if err == errUnknownTypedParamsField {
+		return "", "", code.UnknownFieldInParams, err.Error()
+	}
+	if err != nil {
+		return "", "", code.InvalidTypedParams, err.Error()
+	}
+	return param, string(txObj.TypedParams), code.OK, ""
+}
@@ -0,0 +1,202 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+)
+
+// Billing period of usage report is calendar month (UTC) of block time
+const billingPeriodLayout = "2006-01"
+
+const (
+	usageReportDefaultLimit = 100
+	usageReportMaxLimit     = 1000
+)
+
+// billingPeriodKey returns key of height of the first block of billing period
+// in which token of any node is changed
+func billingPeriodKey(billingPeriod string) []byte {
+	return []byte(billingPeriodKeyPrefix + keySeparator + billingPeriod)
+}
+
+func parseBillingPeriod(billingPeriod string) error {
+	_, err := time.Parse(billingPeriodLayout, billingPeriod)
+	if err != nil {
+		return errors.New("billing period must be in YYYY-MM format")
+	}
+	return nil
+}
+
+// indexBillingPeriod records current block as the first block of billing
+// period of current block if token is not changed in the period before.
+// Token ledger entries of a billing period are in blocks from the first
+// block of the period to the first block of the next indexed period.
+func (app *ABCIApplication) indexBillingPeriod() {
+	key := billingPeriodKey(app.CurrentBlockTime.UTC().Format(billingPeriodLayout))
+	if app.state.Has(key, false) {
+		return
+	}
+	app.state.Set(key, []byte(strconv.FormatInt(app.state.CurrentBlockHeight, 10)))
+}
+
+// billingPeriodBlockRange returns block height range [from, to) of token
+// ledger entries in billing period. Range is empty if no token is changed in
+// the period.
+func (appState *AppState) billingPeriodBlockRange(billingPeriod string) (from int64, to int64, err error) {
+	key := billingPeriodKey(billingPeriod)
+	value, _ := appState.Get(key, true)
+	if value == nil {
+		return 0, 0, nil
+	}
+	from, err = strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	to = math.MaxInt64
+	end := prefixEnd([]byte(billingPeriodKeyPrefix + keySeparator))
+	appState.IterateCommitted(append(key, 0), end, func(key, value []byte) bool {
+		to, err = strconv.ParseInt(string(value), 10, 64)
+		return false
+	})
+	return from, to, err
+}
+
+// iterateUsageReport calls fn with every fee entry of token ledger of node in
+// billing period up to block height in order of charging. Amount of fee entry
+// is negative.
+func (appState *AppState) iterateUsageReport(nodeID string, billingPeriod string, height int64, fn func(entry *data.Report)) error {
+	from, to, err := appState.billingPeriodBlockRange(billingPeriod)
+	if err != nil {
+		return err
+	}
+	if to > height+1 {
+		to = height + 1
+	}
+	if from >= to {
+		return nil
+	}
+	return appState.iterateTokenLedger(nodeID, from, to, func(entry *data.Report) {
+		if entry.Reason == tokenLedgerReasonFee {
+			fn(entry)
+		}
+	})
+}
+
+func (app *ABCIApplication) getUsageReport(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetUsageReport, Parameter: %s", param)
+	var funcParam GetUsageReportParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	err = parseBillingPeriod(funcParam.BillingPeriod)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	limit := funcParam.Limit
+	if limit <= 0 {
+		limit = usageReportDefaultLimit
+	}
+	if limit > usageReportMaxLimit {
+		limit = usageReportMaxLimit
+	}
+	decimals := app.getTokenDecimals(true)
+	var result GetUsageReportResult
+	result.NodeID = funcParam.NodeID
+	result.BillingPeriod = funcParam.BillingPeriod
+	result.Entries = make([]UsageReportEntry, 0)
+	var totalPrice int64
+	// Entries are read up to query height
+	err = app.state.iterateUsageReport(funcParam.NodeID, funcParam.BillingPeriod, height, func(entry *data.Report) {
+		if result.TotalCount >= funcParam.Offset && len(result.Entries) < limit {
+			result.Entries = append(result.Entries, UsageReportEntry{
+				BlockHeight: entry.BlockHeight,
+				Method:      entry.Method,
+				RequestID:   entry.RequestId,
+				Price:       formatTokenAmount(-entry.Amount, decimals),
+			})
+		}
+		result.TotalCount++
+		totalPrice -= entry.Amount
+	})
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	result.TotalPrice = formatTokenAmount(totalPrice, decimals)
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.ReturnQuery(value, "success", app.state.Height)
+}
+
+// ExportUsageReportCSV writes usage report of node in billing period (YYYY-MM)
+// in app state DB as CSV with a header row. It returns number of entries
+// written.
+func ExportUsageReportCSV(db dbm.DB, nodeID string, billingPeriod string, w io.Writer) (count int, err error) {
+	err = parseBillingPeriod(billingPeriod)
+	if err != nil {
+		return 0, err
+	}
+	app := ABCIApplication{state: NewAppState(db)}
+	decimals := app.getTokenDecimals(true)
+	writer := csv.NewWriter(w)
+	err = writer.Write([]string{"node_id", "billing_period", "block_height", "method", "request_id", "price"})
+	if err != nil {
+		return 0, err
+	}
+	iterateErr := app.state.iterateUsageReport(nodeID, billingPeriod, app.state.Height, func(entry *data.Report) {
+		if err != nil {
+			return
+		}
+		err = writer.Write([]string{
+			nodeID,
+			billingPeriod,
+			strconv.FormatInt(entry.BlockHeight, 10),
+			entry.Method,
+			entry.RequestId,
+			string(formatTokenAmount(-entry.Amount, decimals)),
+		})
+		count++
+	})
+	if err != nil {
+		return count, err
+	}
+	if iterateErr != nil {
+		return count, iterateErr
+	}
+	writer.Flush()
+	return count, writer.Error()
+}
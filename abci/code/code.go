@@ -127,5 +127,52 @@ const (
 	CannotRevokeAllAccessorsInThisIdP                  uint32 = 103
 	DuplicateIdentifier                                uint32 = 104
 	NewModeListMustBeHigherThanCurrentModeList         uint32 = 105
+	VersionPruningPolicyValueMustNotBeNegative         uint32 = 106
+	NoPermissionForCallNDIDandIdPMethod                uint32 = 107
+	CannotMergeSameReferenceGroup                      uint32 = 108
+	ActiveIdentifierCountIsGreaterThanAllowedCount     uint32 = 109
+	PendingRegisterIdentityNotFound                    uint32 = 110
+	InvalidSignatureScheme                             uint32 = 111
+	SignatureSchemeIsLowerThanMinimum                  uint32 = 112
+	NDIDMethodMustBeProposedToGovernance               uint32 = 113
+	InvalidGovernanceThreshold                         uint32 = 114
+	DuplicateGovernanceKeyID                           uint32 = 115
+	GovernanceIsNotEnabled                             uint32 = 116
+	NDIDProposalIDIsAlreadyExisted                     uint32 = 117
+	NDIDProposalNotFound                               uint32 = 118
+	NDIDProposalIsNotPending                           uint32 = 119
+	GovernanceKeyNotFound                              uint32 = 120
+	DuplicateNDIDProposalApproval                      uint32 = 121
+	MethodCannotBeProposed                             uint32 = 122
+	BatchOperationsCannotBeEmpty                       uint32 = 123
+	MethodCannotBeInBatch                              uint32 = 124
+	InvalidTypedParams                                 uint32 = 125
+	UnknownFieldInParams                               uint32 = 126
+	TxIsExpired                                        uint32 = 127
+	TxValidUntilBlockIsTooFar                          uint32 = 128
+	InvalidActivationBlockHeight                       uint32 = 129
+	NodeKeyRotationIsPending                           uint32 = 130
+	InvalidGracePeriodBlock                            uint32 = 131
+	DelegateKeyIDCannotBeEmpty                         uint32 = 132
+	DuplicateDelegateKeyID                             uint32 = 133
+	DelegateKeyNotFound                                uint32 = 134
+	DelegateKeyIsExpired                               uint32 = 135
+	MethodIsNotInDelegateKeyScope                      uint32 = 136
+	InvalidDelegateKeyMethod                           uint32 = 137
+	InvalidDelegateKeyExpiryBlock                      uint32 = 138
+	IdpResponseNotFound                                uint32 = 139
+	PriceMustBeGreaterOrEqualToZero                    uint32 = 140
+	NoPermissionForTransferToken                       uint32 = 141
+	TokenTransferIsNotAllowed                          uint32 = 142
+	CannotTransferTokenToItself                        uint32 = 143
+	AmountMustBeGreaterThanZero                        uint32 = 144
+	InvalidOrganizationGroup                           uint32 = 145
+	InvalidTokenAmount                                 uint32 = 146
+	InvalidTokenDecimals                               uint32 = 147
+	InvalidFeePolicy                                   uint32 = 148
+	InvalidEffectiveBlockHeight                        uint32 = 149
+	InvalidPriceFuncOverride                           uint32 = 150
+	CreditLimitMustBeGreaterOrEqualToZero              uint32 = 151
+	LowBalanceThresholdMustBeGreaterOrEqualToZero      uint32 = 152
 	UnknownError                                       uint32 = 999
 )
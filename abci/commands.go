@@ -24,9 +24,13 @@ package main
 
 import (
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/spf13/cobra"
 
+	abciApp "github.com/ndidplatform/smart-contract/v4/abci/app"
+	appV1 "github.com/ndidplatform/smart-contract/v4/abci/app/v1"
 	"github.com/ndidplatform/smart-contract/v4/abci/version"
 )
 
@@ -37,3 +41,135 @@ var abciVersionCmd = &cobra.Command{
 		fmt.Println(version.Version)
 	},
 }
+
+var abciCmd = &cobra.Command{
+	Use:   "abci",
+	Short: "DID ABCI app commands",
+}
+
+var abciStateCmd = &cobra.Command{
+	Use:   "state",
+	Short: "Export or import DID ABCI app state (DB at ABCI_DB_DIR_PATH)",
+}
+
+var abciStateExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export app state to a checksummed snapshot file",
+	Long: `Export app state to a checksummed snapshot file.
+Versioned keys (node, identity, request, service, namespace, token, proxy)
+are exported at --height. Other keys are only stored at the latest height so
+app hash of a snapshot at an older height is unknown and it can only be
+imported with --migrate. The node must be stopped since DB cannot be opened
+by more than one process.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		height, _ := cmd.Flags().GetInt64("height")
+		output, _ := cmd.Flags().GetString("output")
+
+		var w io.Writer = os.Stdout
+		if output != "-" {
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			w = file
+		}
+
+		db := abciApp.NewDB()
+		defer db.Close()
+		metadata, err := appV1.ExportState(db, height, w)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Exported state at height %d, app hash %X\n", metadata.Height, metadata.AppHash)
+		return nil
+	},
+}
+
+var abciStateImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Rebuild app state in an empty DB from a snapshot file",
+	Long: `Rebuild app state in an empty DB from a snapshot file.
+App hash of resulting state is verified against the snapshot. A snapshot
+exported at an older height or before app hash is switched to state tree
+(including a DB of a version without state tree) cannot be verified and
+must be imported with --migrate. In migration mode, root hash of state tree
+is recorded as app hash at snapshot height and app hash of later blocks is
+root hash of state tree (e.g. for starting a new chain from the state).`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		input, _ := cmd.Flags().GetString("input")
+		migrate, _ := cmd.Flags().GetBool("migrate")
+
+		var r io.Reader = os.Stdin
+		if input != "-" {
+			file, err := os.Open(input)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			r = file
+		}
+
+		db := abciApp.NewDB()
+		defer db.Close()
+		metadata, err := appV1.ImportState(db, r, migrate)
+		if err != nil {
+			return fmt.Errorf("import failed, DB should be removed before retrying: %v", err)
+		}
+		fmt.Fprintf(os.Stderr, "Imported state at height %d, app hash %X\n", metadata.Height, metadata.AppHash)
+		return nil
+	},
+}
+
+var abciUsageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "DID ABCI app usage report commands (DB at ABCI_DB_DIR_PATH)",
+}
+
+var abciUsageExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export usage report of a node in a billing period as CSV",
+	Long: `Export usage report of a node in a billing period (YYYY-MM, UTC) as CSV.
+The node must be stopped since DB cannot be opened by more than one process.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		nodeID, _ := cmd.Flags().GetString("node-id")
+		billingPeriod, _ := cmd.Flags().GetString("billing-period")
+		output, _ := cmd.Flags().GetString("output")
+
+		var w io.Writer = os.Stdout
+		if output != "-" {
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			w = file
+		}
+
+		db := abciApp.NewDB()
+		defer db.Close()
+		count, err := appV1.ExportUsageReportCSV(db, nodeID, billingPeriod, w)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Exported %d usage report entries of %s in %s\n", count, nodeID, billingPeriod)
+		return nil
+	},
+}
+
+func init() {
+	abciStateExportCmd.Flags().Int64("height", 0, "Height of state to export (0 for current height of state in DB)")
+	abciStateExportCmd.Flags().StringP("output", "o", "-", "Output file path (\"-\" for stdout)")
+	abciStateImportCmd.Flags().StringP("input", "i", "-", "Input file path (\"-\" for stdin)")
+	abciStateImportCmd.Flags().Bool("migrate", false, "Record root hash of state tree as app hash without verifying app hash of snapshot")
+
+	abciUsageExportCmd.Flags().String("node-id", "", "Node ID")
+	abciUsageExportCmd.Flags().String("billing-period", "", "Billing period in YYYY-MM format")
+	abciUsageExportCmd.Flags().StringP("output", "o", "-", "Output file path (\"-\" for stdout)")
+	abciUsageExportCmd.MarkFlagRequired("node-id")
+	abciUsageExportCmd.MarkFlagRequired("billing-period")
+
+	abciStateCmd.AddCommand(abciStateExportCmd, abciStateImportCmd)
+	abciUsageCmd.AddCommand(abciUsageExportCmd)
+	abciCmd.AddCommand(abciStateCmd, abciUsageCmd)
+}
@@ -113,7 +113,8 @@ func main() {
 		cmd.ShowNodeIDCmd,
 		cmd.GenNodeKeyCmd,
 		cmd.VersionCmd,
-		abciVersionCmd)
+		abciVersionCmd,
+		abciCmd)
 
 	// NOTE:
 	// Users wishing to:
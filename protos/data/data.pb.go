@@ -22,6 +22,7 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type KeyVersions struct {
 	Versions             []int64  `protobuf:"varint,1,rep,packed,name=versions,proto3" json:"versions,omitempty"`
+	PrunedFirstVersion   int64    `protobuf:"varint,2,opt,name=pruned_first_version,json=prunedFirstVersion,proto3" json:"pruned_first_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -59,6 +60,13 @@ func (m *KeyVersions) GetVersions() []int64 {
 	return nil
 }
 
+func (m *KeyVersions) GetPrunedFirstVersion() int64 {
+	if m != nil {
+		return m.PrunedFirstVersion
+	}
+	return 0
+}
+
 type NodeDetail struct {
 	PublicKey                              string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	MasterPublicKey                        string   `protobuf:"bytes,2,opt,name=master_public_key,json=masterPublicKey,proto3" json:"master_public_key,omitempty"`
@@ -71,6 +79,8 @@ type NodeDetail struct {
 	ProxyNodeId                            string   `protobuf:"bytes,9,opt,name=proxy_node_id,json=proxyNodeId,proto3" json:"proxy_node_id,omitempty"`
 	ProxyConfig                            string   `protobuf:"bytes,10,opt,name=proxy_config,json=proxyConfig,proto3" json:"proxy_config,omitempty"`
 	SupportedRequestMessageDataUrlTypeList []string `protobuf:"bytes,11,rep,name=supported_request_message_data_url_type_list,json=supportedRequestMessageDataUrlTypeList,proto3" json:"supported_request_message_data_url_type_list,omitempty"`
+	PublicKeyAlgorithm                     string   `protobuf:"bytes,12,opt,name=public_key_algorithm,json=publicKeyAlgorithm,proto3" json:"public_key_algorithm,omitempty"`
+	MasterPublicKeyAlgorithm               string   `protobuf:"bytes,13,opt,name=master_public_key_algorithm,json=masterPublicKeyAlgorithm,proto3" json:"master_public_key_algorithm,omitempty"`
 	XXX_NoUnkeyedLiteral                   struct{} `json:"-"`
 	XXX_unrecognized                       []byte   `json:"-"`
 	XXX_sizecache                          int32    `json:"-"`
@@ -178,6 +188,20 @@ func (m *NodeDetail) GetSupportedRequestMessageDataUrlTypeList() []string {
 	return nil
 }
 
+func (m *NodeDetail) GetPublicKeyAlgorithm() string {
+	if m != nil {
+		return m.PublicKeyAlgorithm
+	}
+	return ""
+}
+
+func (m *NodeDetail) GetMasterPublicKeyAlgorithm() string {
+	if m != nil {
+		return m.MasterPublicKeyAlgorithm
+	}
+	return ""
+}
+
 type MQ struct {
 	Ip                   string   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
 	Port                 int64    `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
@@ -649,26 +673,31 @@ func (m *BehindNodeList) GetNodes() []string {
 }
 
 type Request struct {
-	RequestId            string         `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	MinIdp               int64          `protobuf:"varint,2,opt,name=min_idp,json=minIdp,proto3" json:"min_idp,omitempty"`
-	MinAal               float64        `protobuf:"fixed64,3,opt,name=min_aal,json=minAal,proto3" json:"min_aal,omitempty"`
-	MinIal               float64        `protobuf:"fixed64,4,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
-	RequestTimeout       int64          `protobuf:"varint,5,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
-	IdpIdList            []string       `protobuf:"bytes,6,rep,name=idp_id_list,json=idpIdList,proto3" json:"idp_id_list,omitempty"`
-	DataRequestList      []*DataRequest `protobuf:"bytes,7,rep,name=data_request_list,json=dataRequestList,proto3" json:"data_request_list,omitempty"`
-	RequestMessageHash   string         `protobuf:"bytes,8,opt,name=request_message_hash,json=requestMessageHash,proto3" json:"request_message_hash,omitempty"`
-	ResponseList         []*Response    `protobuf:"bytes,9,rep,name=response_list,json=responseList,proto3" json:"response_list,omitempty"`
-	Closed               bool           `protobuf:"varint,10,opt,name=closed,proto3" json:"closed,omitempty"`
-	TimedOut             bool           `protobuf:"varint,11,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
-	Purpose              string         `protobuf:"bytes,12,opt,name=purpose,proto3" json:"purpose,omitempty"`
-	Owner                string         `protobuf:"bytes,13,opt,name=owner,proto3" json:"owner,omitempty"`
-	Mode                 int32          `protobuf:"varint,14,opt,name=mode,proto3" json:"mode,omitempty"`
-	UseCount             int64          `protobuf:"varint,15,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
-	CreationBlockHeight  int64          `protobuf:"varint,16,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
-	ChainId              string         `protobuf:"bytes,17,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	RequestId           string             `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	MinIdp              int64              `protobuf:"varint,2,opt,name=min_idp,json=minIdp,proto3" json:"min_idp,omitempty"`
+	MinAal              float64            `protobuf:"fixed64,3,opt,name=min_aal,json=minAal,proto3" json:"min_aal,omitempty"`
+	MinIal              float64            `protobuf:"fixed64,4,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
+	RequestTimeout      int64              `protobuf:"varint,5,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	IdpIdList           []string           `protobuf:"bytes,6,rep,name=idp_id_list,json=idpIdList,proto3" json:"idp_id_list,omitempty"`
+	DataRequestList     []*DataRequest     `protobuf:"bytes,7,rep,name=data_request_list,json=dataRequestList,proto3" json:"data_request_list,omitempty"`
+	RequestMessageHash  string             `protobuf:"bytes,8,opt,name=request_message_hash,json=requestMessageHash,proto3" json:"request_message_hash,omitempty"`
+	ResponseList        []*Response        `protobuf:"bytes,9,rep,name=response_list,json=responseList,proto3" json:"response_list,omitempty"`
+	Closed              bool               `protobuf:"varint,10,opt,name=closed,proto3" json:"closed,omitempty"`
+	TimedOut            bool               `protobuf:"varint,11,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Purpose             string             `protobuf:"bytes,12,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Owner               string             `protobuf:"bytes,13,opt,name=owner,proto3" json:"owner,omitempty"`
+	Mode                int32              `protobuf:"varint,14,opt,name=mode,proto3" json:"mode,omitempty"`
+	UseCount            int64              `protobuf:"varint,15,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
+	CreationBlockHeight int64              `protobuf:"varint,16,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
+	ChainId             string             `protobuf:"bytes,17,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ResponseHistory     []*ResponseHistory `protobuf:"bytes,18,rep,name=response_history,json=responseHistory,proto3" json:"response_history,omitempty"`
+	// token escrowed from owner for each IdP response
+	IdpFeePerResponse int64 `protobuf:"varint,19,opt,name=idp_fee_per_response,json=idpFeePerResponse,proto3" json:"idp_fee_per_response,omitempty"`
+	// escrowed token not yet paid to IdPs
+	IdpEscrowAmount      int64    `protobuf:"varint,20,opt,name=idp_escrow_amount,json=idpEscrowAmount,proto3" json:"idp_escrow_amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
@@ -815,6 +844,27 @@ func (m *Request) GetChainId() string {
 	return ""
 }
 
+func (m *Request) GetResponseHistory() []*ResponseHistory {
+	if m != nil {
+		return m.ResponseHistory
+	}
+	return nil
+}
+
+func (m *Request) GetIdpFeePerResponse() int64 {
+	if m != nil {
+		return m.IdpFeePerResponse
+	}
+	return 0
+}
+
+func (m *Request) GetIdpEscrowAmount() int64 {
+	if m != nil {
+		return m.IdpEscrowAmount
+	}
+	return 0
+}
+
 type DataRequest struct {
 	ServiceId            string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	AsIdList             []string `protobuf:"bytes,2,rep,name=as_id_list,json=asIdList,proto3" json:"as_id_list,omitempty"`
@@ -822,6 +872,10 @@ type DataRequest struct {
 	RequestParamsHash    string   `protobuf:"bytes,4,opt,name=request_params_hash,json=requestParamsHash,proto3" json:"request_params_hash,omitempty"`
 	AnsweredAsIdList     []string `protobuf:"bytes,5,rep,name=answered_as_id_list,json=answeredAsIdList,proto3" json:"answered_as_id_list,omitempty"`
 	ReceivedDataFromList []string `protobuf:"bytes,6,rep,name=received_data_from_list,json=receivedDataFromList,proto3" json:"received_data_from_list,omitempty"`
+	// token escrowed from request owner for each AS
+	FeePerAs int64 `protobuf:"varint,7,opt,name=fee_per_as,json=feePerAs,proto3" json:"fee_per_as,omitempty"`
+	// escrowed token not yet paid to ASes
+	EscrowAmount         int64    `protobuf:"varint,8,opt,name=escrow_amount,json=escrowAmount,proto3" json:"escrow_amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -894,6 +948,20 @@ func (m *DataRequest) GetReceivedDataFromList() []string {
 	return nil
 }
 
+func (m *DataRequest) GetFeePerAs() int64 {
+	if m != nil {
+		return m.FeePerAs
+	}
+	return 0
+}
+
+func (m *DataRequest) GetEscrowAmount() int64 {
+	if m != nil {
+		return m.EscrowAmount
+	}
+	return 0
+}
+
 type Response struct {
 	Ial                  float64  `protobuf:"fixed64,1,opt,name=ial,proto3" json:"ial,omitempty"`
 	Aal                  float64  `protobuf:"fixed64,2,opt,name=aal,proto3" json:"aal,omitempty"`
@@ -981,6 +1049,64 @@ func (m *Response) GetValidSignature() string {
 	return ""
 }
 
+type ResponseHistory struct {
+	// create, update or withdraw
+	Action      string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// only idp_id is set for withdraw
+	Response             *Response `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ResponseHistory) Reset()         { *m = ResponseHistory{} }
+func (m *ResponseHistory) String() string { return proto.CompactTextString(m) }
+func (*ResponseHistory) ProtoMessage()    {}
+func (*ResponseHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{15}
+}
+
+func (m *ResponseHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResponseHistory.Unmarshal(m, b)
+}
+func (m *ResponseHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResponseHistory.Marshal(b, m, deterministic)
+}
+func (m *ResponseHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseHistory.Merge(m, src)
+}
+func (m *ResponseHistory) XXX_Size() int {
+	return xxx_messageInfo_ResponseHistory.Size(m)
+}
+func (m *ResponseHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseHistory proto.InternalMessageInfo
+
+func (m *ResponseHistory) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ResponseHistory) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ResponseHistory) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+// Token ledger entries of node in a block
 type ReportList struct {
 	Reports              []*Report `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -992,7 +1118,7 @@ func (m *ReportList) Reset()         { *m = ReportList{} }
 func (m *ReportList) String() string { return proto.CompactTextString(m) }
 func (*ReportList) ProtoMessage()    {}
 func (*ReportList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{15}
+	return fileDescriptor_492be2f0ffbab25c, []int{16}
 }
 
 func (m *ReportList) XXX_Unmarshal(b []byte) error {
@@ -1020,10 +1146,18 @@ func (m *ReportList) GetReports() []*Report {
 	return nil
 }
 
+// Token ledger entry or usage report entry. Usage report entry has only
+// method, amount (price charged), request_id and block_height.
 type Report struct {
-	Method               string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
-	Price                float64  `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	Data                 string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Method       string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	RequestId    string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	BlockHeight  int64  `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Reason       string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Counterparty string `protobuf:"bytes,6,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// token of node after the change
+	Balance int64 `protobuf:"varint,7,opt,name=balance,proto3" json:"balance,omitempty"`
+	// positive for credit, negative for debit
+	Amount               int64    `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1033,7 +1167,7 @@ func (m *Report) Reset()         { *m = Report{} }
 func (m *Report) String() string { return proto.CompactTextString(m) }
 func (*Report) ProtoMessage()    {}
 func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{16}
+	return fileDescriptor_492be2f0ffbab25c, []int{17}
 }
 
 func (m *Report) XXX_Unmarshal(b []byte) error {
@@ -1061,36 +1195,65 @@ func (m *Report) GetMethod() string {
 	return ""
 }
 
-func (m *Report) GetPrice() float64 {
+func (m *Report) GetRequestId() string {
 	if m != nil {
-		return m.Price
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *Report) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *Report) GetData() string {
+func (m *Report) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Report) GetCounterparty() string {
 	if m != nil {
-		return m.Data
+		return m.Counterparty
 	}
 	return ""
 }
 
+func (m *Report) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *Report) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
 type Accessor struct {
-	AccessorId           string   `protobuf:"bytes,1,opt,name=accessor_id,json=accessorId,proto3" json:"accessor_id,omitempty"`
-	AccessorType         string   `protobuf:"bytes,2,opt,name=accessor_type,json=accessorType,proto3" json:"accessor_type,omitempty"`
-	AccessorPublicKey    string   `protobuf:"bytes,3,opt,name=accessor_public_key,json=accessorPublicKey,proto3" json:"accessor_public_key,omitempty"`
-	Active               bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
-	Owner                string   `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AccessorId                 string   `protobuf:"bytes,1,opt,name=accessor_id,json=accessorId,proto3" json:"accessor_id,omitempty"`
+	AccessorType               string   `protobuf:"bytes,2,opt,name=accessor_type,json=accessorType,proto3" json:"accessor_type,omitempty"`
+	AccessorPublicKey          string   `protobuf:"bytes,3,opt,name=accessor_public_key,json=accessorPublicKey,proto3" json:"accessor_public_key,omitempty"`
+	Active                     bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Owner                      string   `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	AccessorPublicKeyAlgorithm string   `protobuf:"bytes,6,opt,name=accessor_public_key_algorithm,json=accessorPublicKeyAlgorithm,proto3" json:"accessor_public_key_algorithm,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
 }
 
 func (m *Accessor) Reset()         { *m = Accessor{} }
 func (m *Accessor) String() string { return proto.CompactTextString(m) }
 func (*Accessor) ProtoMessage()    {}
 func (*Accessor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{17}
+	return fileDescriptor_492be2f0ffbab25c, []int{18}
 }
 
 func (m *Accessor) XXX_Unmarshal(b []byte) error {
@@ -1146,6 +1309,13 @@ func (m *Accessor) GetOwner() string {
 	return ""
 }
 
+func (m *Accessor) GetAccessorPublicKeyAlgorithm() string {
+	if m != nil {
+		return m.AccessorPublicKeyAlgorithm
+	}
+	return ""
+}
+
 type MsqDesList struct {
 	Nodes                []*Node  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1157,7 +1327,7 @@ func (m *MsqDesList) Reset()         { *m = MsqDesList{} }
 func (m *MsqDesList) String() string { return proto.CompactTextString(m) }
 func (*MsqDesList) ProtoMessage()    {}
 func (*MsqDesList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{18}
+	return fileDescriptor_492be2f0ffbab25c, []int{19}
 }
 
 func (m *MsqDesList) XXX_Unmarshal(b []byte) error {
@@ -1200,7 +1370,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{19}
+	return fileDescriptor_492be2f0ffbab25c, []int{20}
 }
 
 func (m *Node) XXX_Unmarshal(b []byte) error {
@@ -1267,7 +1437,7 @@ func (m *ServiceList) Reset()         { *m = ServiceList{} }
 func (m *ServiceList) String() string { return proto.CompactTextString(m) }
 func (*ServiceList) ProtoMessage()    {}
 func (*ServiceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{20}
+	return fileDescriptor_492be2f0ffbab25c, []int{21}
 }
 
 func (m *ServiceList) XXX_Unmarshal(b []byte) error {
@@ -1311,7 +1481,7 @@ func (m *Service) Reset()         { *m = Service{} }
 func (m *Service) String() string { return proto.CompactTextString(m) }
 func (*Service) ProtoMessage()    {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{21}
+	return fileDescriptor_492be2f0ffbab25c, []int{22}
 }
 
 func (m *Service) XXX_Unmarshal(b []byte) error {
@@ -1385,7 +1555,7 @@ func (m *ServiceDesList) Reset()         { *m = ServiceDesList{} }
 func (m *ServiceDesList) String() string { return proto.CompactTextString(m) }
 func (*ServiceDesList) ProtoMessage()    {}
 func (*ServiceDesList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{22}
+	return fileDescriptor_492be2f0ffbab25c, []int{23}
 }
 
 func (m *ServiceDesList) XXX_Unmarshal(b []byte) error {
@@ -1420,16 +1590,18 @@ type ASNode struct {
 	ServiceId              string   `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	SupportedNamespaceList []string `protobuf:"bytes,5,rep,name=supported_namespace_list,json=supportedNamespaceList,proto3" json:"supported_namespace_list,omitempty"`
 	Active                 bool     `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	// token paid to AS for each data request of service
+	Price                int64    `protobuf:"varint,7,opt,name=price,proto3" json:"price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ASNode) Reset()         { *m = ASNode{} }
 func (m *ASNode) String() string { return proto.CompactTextString(m) }
 func (*ASNode) ProtoMessage()    {}
 func (*ASNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{23}
+	return fileDescriptor_492be2f0ffbab25c, []int{24}
 }
 
 func (m *ASNode) XXX_Unmarshal(b []byte) error {
@@ -1492,6 +1664,13 @@ func (m *ASNode) GetActive() bool {
 	return false
 }
 
+func (m *ASNode) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
 type RPList struct {
 	NodeId               []string `protobuf:"bytes,1,rep,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1503,7 +1682,7 @@ func (m *RPList) Reset()         { *m = RPList{} }
 func (m *RPList) String() string { return proto.CompactTextString(m) }
 func (*RPList) ProtoMessage()    {}
 func (*RPList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{24}
+	return fileDescriptor_492be2f0ffbab25c, []int{25}
 }
 
 func (m *RPList) XXX_Unmarshal(b []byte) error {
@@ -1542,7 +1721,7 @@ func (m *ASList) Reset()         { *m = ASList{} }
 func (m *ASList) String() string { return proto.CompactTextString(m) }
 func (*ASList) ProtoMessage()    {}
 func (*ASList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{25}
+	return fileDescriptor_492be2f0ffbab25c, []int{26}
 }
 
 func (m *ASList) XXX_Unmarshal(b []byte) error {
@@ -1581,7 +1760,7 @@ func (m *AllList) Reset()         { *m = AllList{} }
 func (m *AllList) String() string { return proto.CompactTextString(m) }
 func (*AllList) ProtoMessage()    {}
 func (*AllList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{26}
+	return fileDescriptor_492be2f0ffbab25c, []int{27}
 }
 
 func (m *AllList) XXX_Unmarshal(b []byte) error {
@@ -1620,7 +1799,7 @@ func (m *AccessorInGroup) Reset()         { *m = AccessorInGroup{} }
 func (m *AccessorInGroup) String() string { return proto.CompactTextString(m) }
 func (*AccessorInGroup) ProtoMessage()    {}
 func (*AccessorInGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{27}
+	return fileDescriptor_492be2f0ffbab25c, []int{28}
 }
 
 func (m *AccessorInGroup) XXX_Unmarshal(b []byte) error {
@@ -1649,7 +1828,12 @@ func (m *AccessorInGroup) GetAccessors() []string {
 }
 
 type Token struct {
-	Amount               float64  `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// legacy amount in token, converted to minor_amount when read
+	Amount      float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	MinorAmount int64   `protobuf:"varint,2,opt,name=minor_amount,json=minorAmount,proto3" json:"minor_amount,omitempty"`
+	// balance can be negative down to -credit_limit
+	CreditLimit          int64    `protobuf:"varint,3,opt,name=credit_limit,json=creditLimit,proto3" json:"credit_limit,omitempty"`
+	LowBalanceThreshold  int64    `protobuf:"varint,4,opt,name=low_balance_threshold,json=lowBalanceThreshold,proto3" json:"low_balance_threshold,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1659,7 +1843,7 @@ func (m *Token) Reset()         { *m = Token{} }
 func (m *Token) String() string { return proto.CompactTextString(m) }
 func (*Token) ProtoMessage()    {}
 func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{28}
+	return fileDescriptor_492be2f0ffbab25c, []int{29}
 }
 
 func (m *Token) XXX_Unmarshal(b []byte) error {
@@ -1687,8 +1871,31 @@ func (m *Token) GetAmount() float64 {
 	return 0
 }
 
+func (m *Token) GetMinorAmount() int64 {
+	if m != nil {
+		return m.MinorAmount
+	}
+	return 0
+}
+
+func (m *Token) GetCreditLimit() int64 {
+	if m != nil {
+		return m.CreditLimit
+	}
+	return 0
+}
+
+func (m *Token) GetLowBalanceThreshold() int64 {
+	if m != nil {
+		return m.LowBalanceThreshold
+	}
+	return 0
+}
+
 type TokenPrice struct {
+	// legacy price in token, converted to minor_price when read
 	Price                float64  `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	MinorPrice           int64    `protobuf:"varint,2,opt,name=minor_price,json=minorPrice,proto3" json:"minor_price,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1698,7 +1905,7 @@ func (m *TokenPrice) Reset()         { *m = TokenPrice{} }
 func (m *TokenPrice) String() string { return proto.CompactTextString(m) }
 func (*TokenPrice) ProtoMessage()    {}
 func (*TokenPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{29}
+	return fileDescriptor_492be2f0ffbab25c, []int{30}
 }
 
 func (m *TokenPrice) XXX_Unmarshal(b []byte) error {
@@ -1726,128 +1933,345 @@ func (m *TokenPrice) GetPrice() float64 {
 	return 0
 }
 
-type ReferenceGroup struct {
-	Identities           []*IdentityInRefGroup `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
-	Idps                 []*IdPInRefGroup      `protobuf:"bytes,2,rep,name=idps,proto3" json:"idps,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
-}
-
-func (m *ReferenceGroup) Reset()         { *m = ReferenceGroup{} }
-func (m *ReferenceGroup) String() string { return proto.CompactTextString(m) }
-func (*ReferenceGroup) ProtoMessage()    {}
-func (*ReferenceGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{30}
-}
-
-func (m *ReferenceGroup) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReferenceGroup.Unmarshal(m, b)
-}
-func (m *ReferenceGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReferenceGroup.Marshal(b, m, deterministic)
-}
-func (m *ReferenceGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReferenceGroup.Merge(m, src)
-}
-func (m *ReferenceGroup) XXX_Size() int {
-	return xxx_messageInfo_ReferenceGroup.Size(m)
-}
-func (m *ReferenceGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReferenceGroup.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ReferenceGroup proto.InternalMessageInfo
-
-func (m *ReferenceGroup) GetIdentities() []*IdentityInRefGroup {
-	if m != nil {
-		return m.Identities
-	}
-	return nil
-}
-
-func (m *ReferenceGroup) GetIdps() []*IdPInRefGroup {
+func (m *TokenPrice) GetMinorPrice() int64 {
 	if m != nil {
-		return m.Idps
+		return m.MinorPrice
 	}
-	return nil
+	return 0
 }
 
-type IdPInRefGroup struct {
-	NodeId               string      `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Mode                 []int32     `protobuf:"varint,2,rep,packed,name=mode,proto3" json:"mode,omitempty"`
-	Accessors            []*Accessor `protobuf:"bytes,3,rep,name=accessors,proto3" json:"accessors,omitempty"`
-	Ial                  float64     `protobuf:"fixed64,4,opt,name=ial,proto3" json:"ial,omitempty"`
-	Active               bool        `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type PriceFuncScheduleEntry struct {
+	EffectiveBlockHeight int64 `protobuf:"varint,1,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+	// price override for nodes of role or for node, empty for every node
+	Role   string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	NodeId string `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Price  int64  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	// override of role or node is no longer in effect from effective block height
+	RemoveOverride       bool     `protobuf:"varint,5,opt,name=remove_override,json=removeOverride,proto3" json:"remove_override,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IdPInRefGroup) Reset()         { *m = IdPInRefGroup{} }
-func (m *IdPInRefGroup) String() string { return proto.CompactTextString(m) }
-func (*IdPInRefGroup) ProtoMessage()    {}
-func (*IdPInRefGroup) Descriptor() ([]byte, []int) {
+func (m *PriceFuncScheduleEntry) Reset()         { *m = PriceFuncScheduleEntry{} }
+func (m *PriceFuncScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*PriceFuncScheduleEntry) ProtoMessage()    {}
+func (*PriceFuncScheduleEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_492be2f0ffbab25c, []int{31}
 }
 
-func (m *IdPInRefGroup) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IdPInRefGroup.Unmarshal(m, b)
+func (m *PriceFuncScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceFuncScheduleEntry.Unmarshal(m, b)
 }
-func (m *IdPInRefGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IdPInRefGroup.Marshal(b, m, deterministic)
+func (m *PriceFuncScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceFuncScheduleEntry.Marshal(b, m, deterministic)
 }
-func (m *IdPInRefGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IdPInRefGroup.Merge(m, src)
+func (m *PriceFuncScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceFuncScheduleEntry.Merge(m, src)
 }
-func (m *IdPInRefGroup) XXX_Size() int {
-	return xxx_messageInfo_IdPInRefGroup.Size(m)
+func (m *PriceFuncScheduleEntry) XXX_Size() int {
+	return xxx_messageInfo_PriceFuncScheduleEntry.Size(m)
 }
-func (m *IdPInRefGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_IdPInRefGroup.DiscardUnknown(m)
+func (m *PriceFuncScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceFuncScheduleEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IdPInRefGroup proto.InternalMessageInfo
+var xxx_messageInfo_PriceFuncScheduleEntry proto.InternalMessageInfo
 
-func (m *IdPInRefGroup) GetNodeId() string {
+func (m *PriceFuncScheduleEntry) GetEffectiveBlockHeight() int64 {
 	if m != nil {
-		return m.NodeId
+		return m.EffectiveBlockHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *IdPInRefGroup) GetMode() []int32 {
+func (m *PriceFuncScheduleEntry) GetRole() string {
 	if m != nil {
-		return m.Mode
+		return m.Role
 	}
-	return nil
+	return ""
 }
 
-func (m *IdPInRefGroup) GetAccessors() []*Accessor {
+func (m *PriceFuncScheduleEntry) GetNodeId() string {
 	if m != nil {
-		return m.Accessors
+		return m.NodeId
 	}
-	return nil
+	return ""
 }
 
-func (m *IdPInRefGroup) GetIal() float64 {
+func (m *PriceFuncScheduleEntry) GetPrice() int64 {
 	if m != nil {
-		return m.Ial
+		return m.Price
 	}
 	return 0
 }
 
-func (m *IdPInRefGroup) GetActive() bool {
+func (m *PriceFuncScheduleEntry) GetRemoveOverride() bool {
 	if m != nil {
-		return m.Active
+		return m.RemoveOverride
 	}
 	return false
 }
 
-type IdentityInRefGroup struct {
-	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	IdentifierHash       string   `protobuf:"bytes,2,opt,name=identifier_hash,json=identifierHash,proto3" json:"identifier_hash,omitempty"`
-	Active               bool     `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+// entries are sorted by effective block height
+type PriceFuncSchedule struct {
+	Entries              []*PriceFuncScheduleEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *PriceFuncSchedule) Reset()         { *m = PriceFuncSchedule{} }
+func (m *PriceFuncSchedule) String() string { return proto.CompactTextString(m) }
+func (*PriceFuncSchedule) ProtoMessage()    {}
+func (*PriceFuncSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{32}
+}
+
+func (m *PriceFuncSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceFuncSchedule.Unmarshal(m, b)
+}
+func (m *PriceFuncSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceFuncSchedule.Marshal(b, m, deterministic)
+}
+func (m *PriceFuncSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceFuncSchedule.Merge(m, src)
+}
+func (m *PriceFuncSchedule) XXX_Size() int {
+	return xxx_messageInfo_PriceFuncSchedule.Size(m)
+}
+func (m *PriceFuncSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceFuncSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceFuncSchedule proto.InternalMessageInfo
+
+func (m *PriceFuncSchedule) GetEntries() []*PriceFuncScheduleEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type TokenDecimals struct {
+	Decimals             uint32   `protobuf:"varint,1,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenDecimals) Reset()         { *m = TokenDecimals{} }
+func (m *TokenDecimals) String() string { return proto.CompactTextString(m) }
+func (*TokenDecimals) ProtoMessage()    {}
+func (*TokenDecimals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{33}
+}
+
+func (m *TokenDecimals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenDecimals.Unmarshal(m, b)
+}
+func (m *TokenDecimals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenDecimals.Marshal(b, m, deterministic)
+}
+func (m *TokenDecimals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenDecimals.Merge(m, src)
+}
+func (m *TokenDecimals) XXX_Size() int {
+	return xxx_messageInfo_TokenDecimals.Size(m)
+}
+func (m *TokenDecimals) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenDecimals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenDecimals proto.InternalMessageInfo
+
+func (m *TokenDecimals) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+type FeePolicy struct {
+	// always, success_only, reduced_on_failure or waive
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// fee charged when Tx fails in reduced_on_failure mode
+	FailureFee           int64    `protobuf:"varint,2,opt,name=failure_fee,json=failureFee,proto3" json:"failure_fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeePolicy) Reset()         { *m = FeePolicy{} }
+func (m *FeePolicy) String() string { return proto.CompactTextString(m) }
+func (*FeePolicy) ProtoMessage()    {}
+func (*FeePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{34}
+}
+
+func (m *FeePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeePolicy.Unmarshal(m, b)
+}
+func (m *FeePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeePolicy.Marshal(b, m, deterministic)
+}
+func (m *FeePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePolicy.Merge(m, src)
+}
+func (m *FeePolicy) XXX_Size() int {
+	return xxx_messageInfo_FeePolicy.Size(m)
+}
+func (m *FeePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePolicy proto.InternalMessageInfo
+
+func (m *FeePolicy) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *FeePolicy) GetFailureFee() int64 {
+	if m != nil {
+		return m.FailureFee
+	}
+	return 0
+}
+
+type ReferenceGroup struct {
+	Identities           []*IdentityInRefGroup `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	Idps                 []*IdPInRefGroup      `protobuf:"bytes,2,rep,name=idps,proto3" json:"idps,omitempty"`
+	MergedInto           string                `protobuf:"bytes,3,opt,name=merged_into,json=mergedInto,proto3" json:"merged_into,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReferenceGroup) Reset()         { *m = ReferenceGroup{} }
+func (m *ReferenceGroup) String() string { return proto.CompactTextString(m) }
+func (*ReferenceGroup) ProtoMessage()    {}
+func (*ReferenceGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{35}
+}
+
+func (m *ReferenceGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReferenceGroup.Unmarshal(m, b)
+}
+func (m *ReferenceGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReferenceGroup.Marshal(b, m, deterministic)
+}
+func (m *ReferenceGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferenceGroup.Merge(m, src)
+}
+func (m *ReferenceGroup) XXX_Size() int {
+	return xxx_messageInfo_ReferenceGroup.Size(m)
+}
+func (m *ReferenceGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferenceGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferenceGroup proto.InternalMessageInfo
+
+func (m *ReferenceGroup) GetIdentities() []*IdentityInRefGroup {
+	if m != nil {
+		return m.Identities
+	}
+	return nil
+}
+
+func (m *ReferenceGroup) GetIdps() []*IdPInRefGroup {
+	if m != nil {
+		return m.Idps
+	}
+	return nil
+}
+
+func (m *ReferenceGroup) GetMergedInto() string {
+	if m != nil {
+		return m.MergedInto
+	}
+	return ""
+}
+
+type IdPInRefGroup struct {
+	NodeId               string      `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Mode                 []int32     `protobuf:"varint,2,rep,packed,name=mode,proto3" json:"mode,omitempty"`
+	Accessors            []*Accessor `protobuf:"bytes,3,rep,name=accessors,proto3" json:"accessors,omitempty"`
+	Ial                  float64     `protobuf:"fixed64,4,opt,name=ial,proto3" json:"ial,omitempty"`
+	Active               bool        `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *IdPInRefGroup) Reset()         { *m = IdPInRefGroup{} }
+func (m *IdPInRefGroup) String() string { return proto.CompactTextString(m) }
+func (*IdPInRefGroup) ProtoMessage()    {}
+func (*IdPInRefGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{36}
+}
+
+func (m *IdPInRefGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IdPInRefGroup.Unmarshal(m, b)
+}
+func (m *IdPInRefGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IdPInRefGroup.Marshal(b, m, deterministic)
+}
+func (m *IdPInRefGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdPInRefGroup.Merge(m, src)
+}
+func (m *IdPInRefGroup) XXX_Size() int {
+	return xxx_messageInfo_IdPInRefGroup.Size(m)
+}
+func (m *IdPInRefGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdPInRefGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdPInRefGroup proto.InternalMessageInfo
+
+func (m *IdPInRefGroup) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *IdPInRefGroup) GetMode() []int32 {
+	if m != nil {
+		return m.Mode
+	}
+	return nil
+}
+
+func (m *IdPInRefGroup) GetAccessors() []*Accessor {
+	if m != nil {
+		return m.Accessors
+	}
+	return nil
+}
+
+func (m *IdPInRefGroup) GetIal() float64 {
+	if m != nil {
+		return m.Ial
+	}
+	return 0
+}
+
+func (m *IdPInRefGroup) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+type IdentityInRefGroup struct {
+	Namespace      string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	IdentifierHash string `protobuf:"bytes,2,opt,name=identifier_hash,json=identifierHash,proto3" json:"identifier_hash,omitempty"`
+	// zero value means active so existing identities stay active
+	Inactive             bool     `protobuf:"varint,4,opt,name=inactive,proto3" json:"inactive,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1857,7 +2281,7 @@ func (m *IdentityInRefGroup) Reset()         { *m = IdentityInRefGroup{} }
 func (m *IdentityInRefGroup) String() string { return proto.CompactTextString(m) }
 func (*IdentityInRefGroup) ProtoMessage()    {}
 func (*IdentityInRefGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{32}
+	return fileDescriptor_492be2f0ffbab25c, []int{37}
 }
 
 func (m *IdentityInRefGroup) XXX_Unmarshal(b []byte) error {
@@ -1892,13 +2316,94 @@ func (m *IdentityInRefGroup) GetIdentifierHash() string {
 	return ""
 }
 
-func (m *IdentityInRefGroup) GetActive() bool {
+func (m *IdentityInRefGroup) GetInactive() bool {
 	if m != nil {
-		return m.Active
+		return m.Inactive
 	}
 	return false
 }
 
+type PendingRegisterIdentity struct {
+	NodeId             string                `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	AccessorId         string                `protobuf:"bytes,2,opt,name=accessor_id,json=accessorId,proto3" json:"accessor_id,omitempty"`
+	Identities         []*IdentityInRefGroup `protobuf:"bytes,3,rep,name=identities,proto3" json:"identities,omitempty"`
+	TimeoutBlock       int64                 `protobuf:"varint,5,opt,name=timeout_block,json=timeoutBlock,proto3" json:"timeout_block,omitempty"`
+	ReferenceGroupCode string                `protobuf:"bytes,6,opt,name=reference_group_code,json=referenceGroupCode,proto3" json:"reference_group_code,omitempty"`
+	// IdP entry (mode, IAL and accessor) added to reference group when
+	// registration is cleared
+	Idp                  *IdPInRefGroup `protobuf:"bytes,7,opt,name=idp,proto3" json:"idp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PendingRegisterIdentity) Reset()         { *m = PendingRegisterIdentity{} }
+func (m *PendingRegisterIdentity) String() string { return proto.CompactTextString(m) }
+func (*PendingRegisterIdentity) ProtoMessage()    {}
+func (*PendingRegisterIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{38}
+}
+
+func (m *PendingRegisterIdentity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingRegisterIdentity.Unmarshal(m, b)
+}
+func (m *PendingRegisterIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingRegisterIdentity.Marshal(b, m, deterministic)
+}
+func (m *PendingRegisterIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRegisterIdentity.Merge(m, src)
+}
+func (m *PendingRegisterIdentity) XXX_Size() int {
+	return xxx_messageInfo_PendingRegisterIdentity.Size(m)
+}
+func (m *PendingRegisterIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRegisterIdentity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRegisterIdentity proto.InternalMessageInfo
+
+func (m *PendingRegisterIdentity) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *PendingRegisterIdentity) GetAccessorId() string {
+	if m != nil {
+		return m.AccessorId
+	}
+	return ""
+}
+
+func (m *PendingRegisterIdentity) GetIdentities() []*IdentityInRefGroup {
+	if m != nil {
+		return m.Identities
+	}
+	return nil
+}
+
+func (m *PendingRegisterIdentity) GetTimeoutBlock() int64 {
+	if m != nil {
+		return m.TimeoutBlock
+	}
+	return 0
+}
+
+func (m *PendingRegisterIdentity) GetReferenceGroupCode() string {
+	if m != nil {
+		return m.ReferenceGroupCode
+	}
+	return ""
+}
+
+func (m *PendingRegisterIdentity) GetIdp() *IdPInRefGroup {
+	if m != nil {
+		return m.Idp
+	}
+	return nil
+}
+
 type AllowedModeList struct {
 	Mode                 []int32  `protobuf:"varint,1,rep,packed,name=mode,proto3" json:"mode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1910,7 +2415,7 @@ func (m *AllowedModeList) Reset()         { *m = AllowedModeList{} }
 func (m *AllowedModeList) String() string { return proto.CompactTextString(m) }
 func (*AllowedModeList) ProtoMessage()    {}
 func (*AllowedModeList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{33}
+	return fileDescriptor_492be2f0ffbab25c, []int{39}
 }
 
 func (m *AllowedModeList) XXX_Unmarshal(b []byte) error {
@@ -1953,7 +2458,7 @@ func (m *AllowedMinIalForRegisterIdentityAtFirstIdp) String() string {
 }
 func (*AllowedMinIalForRegisterIdentityAtFirstIdp) ProtoMessage() {}
 func (*AllowedMinIalForRegisterIdentityAtFirstIdp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_492be2f0ffbab25c, []int{34}
+	return fileDescriptor_492be2f0ffbab25c, []int{40}
 }
 
 func (m *AllowedMinIalForRegisterIdentityAtFirstIdp) XXX_Unmarshal(b []byte) error {
@@ -1981,6 +2486,630 @@ func (m *AllowedMinIalForRegisterIdentityAtFirstIdp) GetMinIal() float64 {
 	return 0
 }
 
+type VersionPruningPolicy struct {
+	KeepLastVersions                    int64    `protobuf:"varint,1,opt,name=keep_last_versions,json=keepLastVersions,proto3" json:"keep_last_versions,omitempty"`
+	KeepRecentBlocks                    int64    `protobuf:"varint,2,opt,name=keep_recent_blocks,json=keepRecentBlocks,proto3" json:"keep_recent_blocks,omitempty"`
+	KeepOnlyFinalVersionOfClosedRequest bool     `protobuf:"varint,3,opt,name=keep_only_final_version_of_closed_request,json=keepOnlyFinalVersionOfClosedRequest,proto3" json:"keep_only_final_version_of_closed_request,omitempty"`
+	XXX_NoUnkeyedLiteral                struct{} `json:"-"`
+	XXX_unrecognized                    []byte   `json:"-"`
+	XXX_sizecache                       int32    `json:"-"`
+}
+
+func (m *VersionPruningPolicy) Reset()         { *m = VersionPruningPolicy{} }
+func (m *VersionPruningPolicy) String() string { return proto.CompactTextString(m) }
+func (*VersionPruningPolicy) ProtoMessage()    {}
+func (*VersionPruningPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{41}
+}
+
+func (m *VersionPruningPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionPruningPolicy.Unmarshal(m, b)
+}
+func (m *VersionPruningPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionPruningPolicy.Marshal(b, m, deterministic)
+}
+func (m *VersionPruningPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionPruningPolicy.Merge(m, src)
+}
+func (m *VersionPruningPolicy) XXX_Size() int {
+	return xxx_messageInfo_VersionPruningPolicy.Size(m)
+}
+func (m *VersionPruningPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionPruningPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionPruningPolicy proto.InternalMessageInfo
+
+func (m *VersionPruningPolicy) GetKeepLastVersions() int64 {
+	if m != nil {
+		return m.KeepLastVersions
+	}
+	return 0
+}
+
+func (m *VersionPruningPolicy) GetKeepRecentBlocks() int64 {
+	if m != nil {
+		return m.KeepRecentBlocks
+	}
+	return 0
+}
+
+func (m *VersionPruningPolicy) GetKeepOnlyFinalVersionOfClosedRequest() bool {
+	if m != nil {
+		return m.KeepOnlyFinalVersionOfClosedRequest
+	}
+	return false
+}
+
+type MinimumSignatureScheme struct {
+	SignatureScheme      int32    `protobuf:"varint,1,opt,name=signature_scheme,json=signatureScheme,proto3" json:"signature_scheme,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MinimumSignatureScheme) Reset()         { *m = MinimumSignatureScheme{} }
+func (m *MinimumSignatureScheme) String() string { return proto.CompactTextString(m) }
+func (*MinimumSignatureScheme) ProtoMessage()    {}
+func (*MinimumSignatureScheme) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{42}
+}
+
+func (m *MinimumSignatureScheme) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MinimumSignatureScheme.Unmarshal(m, b)
+}
+func (m *MinimumSignatureScheme) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MinimumSignatureScheme.Marshal(b, m, deterministic)
+}
+func (m *MinimumSignatureScheme) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinimumSignatureScheme.Merge(m, src)
+}
+func (m *MinimumSignatureScheme) XXX_Size() int {
+	return xxx_messageInfo_MinimumSignatureScheme.Size(m)
+}
+func (m *MinimumSignatureScheme) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinimumSignatureScheme.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinimumSignatureScheme proto.InternalMessageInfo
+
+func (m *MinimumSignatureScheme) GetSignatureScheme() int32 {
+	if m != nil {
+		return m.SignatureScheme
+	}
+	return 0
+}
+
+type GovernanceKey struct {
+	KeyId                string   `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	PublicKey            string   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	PublicKeyAlgorithm   string   `protobuf:"bytes,3,opt,name=public_key_algorithm,json=publicKeyAlgorithm,proto3" json:"public_key_algorithm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GovernanceKey) Reset()         { *m = GovernanceKey{} }
+func (m *GovernanceKey) String() string { return proto.CompactTextString(m) }
+func (*GovernanceKey) ProtoMessage()    {}
+func (*GovernanceKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{43}
+}
+
+func (m *GovernanceKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernanceKey.Unmarshal(m, b)
+}
+func (m *GovernanceKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernanceKey.Marshal(b, m, deterministic)
+}
+func (m *GovernanceKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceKey.Merge(m, src)
+}
+func (m *GovernanceKey) XXX_Size() int {
+	return xxx_messageInfo_GovernanceKey.Size(m)
+}
+func (m *GovernanceKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceKey proto.InternalMessageInfo
+
+func (m *GovernanceKey) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *GovernanceKey) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *GovernanceKey) GetPublicKeyAlgorithm() string {
+	if m != nil {
+		return m.PublicKeyAlgorithm
+	}
+	return ""
+}
+
+type Governance struct {
+	Keys                 []*GovernanceKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Threshold            int32            `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ProposalTimeoutBlock int64            `protobuf:"varint,3,opt,name=proposal_timeout_block,json=proposalTimeoutBlock,proto3" json:"proposal_timeout_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Governance) Reset()         { *m = Governance{} }
+func (m *Governance) String() string { return proto.CompactTextString(m) }
+func (*Governance) ProtoMessage()    {}
+func (*Governance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{44}
+}
+
+func (m *Governance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Governance.Unmarshal(m, b)
+}
+func (m *Governance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Governance.Marshal(b, m, deterministic)
+}
+func (m *Governance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Governance.Merge(m, src)
+}
+func (m *Governance) XXX_Size() int {
+	return xxx_messageInfo_Governance.Size(m)
+}
+func (m *Governance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Governance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Governance proto.InternalMessageInfo
+
+func (m *Governance) GetKeys() []*GovernanceKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *Governance) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Governance) GetProposalTimeoutBlock() int64 {
+	if m != nil {
+		return m.ProposalTimeoutBlock
+	}
+	return 0
+}
+
+type NDIDProposal struct {
+	ProposalId           string   `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Method               string   `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Params               string   `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	Approvals            []string `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	CreationBlockHeight  int64    `protobuf:"varint,5,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
+	DeadlineBlock        int64    `protobuf:"varint,6,opt,name=deadline_block,json=deadlineBlock,proto3" json:"deadline_block,omitempty"`
+	Status               string   `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ResultCode           uint32   `protobuf:"varint,8,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
+	ResultLog            string   `protobuf:"bytes,9,opt,name=result_log,json=resultLog,proto3" json:"result_log,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NDIDProposal) Reset()         { *m = NDIDProposal{} }
+func (m *NDIDProposal) String() string { return proto.CompactTextString(m) }
+func (*NDIDProposal) ProtoMessage()    {}
+func (*NDIDProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{45}
+}
+
+func (m *NDIDProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NDIDProposal.Unmarshal(m, b)
+}
+func (m *NDIDProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NDIDProposal.Marshal(b, m, deterministic)
+}
+func (m *NDIDProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NDIDProposal.Merge(m, src)
+}
+func (m *NDIDProposal) XXX_Size() int {
+	return xxx_messageInfo_NDIDProposal.Size(m)
+}
+func (m *NDIDProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_NDIDProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NDIDProposal proto.InternalMessageInfo
+
+func (m *NDIDProposal) GetProposalId() string {
+	if m != nil {
+		return m.ProposalId
+	}
+	return ""
+}
+
+func (m *NDIDProposal) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *NDIDProposal) GetParams() string {
+	if m != nil {
+		return m.Params
+	}
+	return ""
+}
+
+func (m *NDIDProposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *NDIDProposal) GetCreationBlockHeight() int64 {
+	if m != nil {
+		return m.CreationBlockHeight
+	}
+	return 0
+}
+
+func (m *NDIDProposal) GetDeadlineBlock() int64 {
+	if m != nil {
+		return m.DeadlineBlock
+	}
+	return 0
+}
+
+func (m *NDIDProposal) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *NDIDProposal) GetResultCode() uint32 {
+	if m != nil {
+		return m.ResultCode
+	}
+	return 0
+}
+
+func (m *NDIDProposal) GetResultLog() string {
+	if m != nil {
+		return m.ResultLog
+	}
+	return ""
+}
+
+type NodeKey struct {
+	PublicKey          string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	PublicKeyAlgorithm string `protobuf:"bytes,2,opt,name=public_key_algorithm,json=publicKeyAlgorithm,proto3" json:"public_key_algorithm,omitempty"`
+	ValidFromBlock     int64  `protobuf:"varint,3,opt,name=valid_from_block,json=validFromBlock,proto3" json:"valid_from_block,omitempty"`
+	// 0 if key has no end of validity
+	ValidToBlock         int64    `protobuf:"varint,4,opt,name=valid_to_block,json=validToBlock,proto3" json:"valid_to_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeKey) Reset()         { *m = NodeKey{} }
+func (m *NodeKey) String() string { return proto.CompactTextString(m) }
+func (*NodeKey) ProtoMessage()    {}
+func (*NodeKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{46}
+}
+
+func (m *NodeKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeKey.Unmarshal(m, b)
+}
+func (m *NodeKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeKey.Marshal(b, m, deterministic)
+}
+func (m *NodeKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeKey.Merge(m, src)
+}
+func (m *NodeKey) XXX_Size() int {
+	return xxx_messageInfo_NodeKey.Size(m)
+}
+func (m *NodeKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeKey proto.InternalMessageInfo
+
+func (m *NodeKey) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *NodeKey) GetPublicKeyAlgorithm() string {
+	if m != nil {
+		return m.PublicKeyAlgorithm
+	}
+	return ""
+}
+
+func (m *NodeKey) GetValidFromBlock() int64 {
+	if m != nil {
+		return m.ValidFromBlock
+	}
+	return 0
+}
+
+func (m *NodeKey) GetValidToBlock() int64 {
+	if m != nil {
+		return m.ValidToBlock
+	}
+	return 0
+}
+
+type NodeKeyHistory struct {
+	Keys                 []*NodeKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *NodeKeyHistory) Reset()         { *m = NodeKeyHistory{} }
+func (m *NodeKeyHistory) String() string { return proto.CompactTextString(m) }
+func (*NodeKeyHistory) ProtoMessage()    {}
+func (*NodeKeyHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{47}
+}
+
+func (m *NodeKeyHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeKeyHistory.Unmarshal(m, b)
+}
+func (m *NodeKeyHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeKeyHistory.Marshal(b, m, deterministic)
+}
+func (m *NodeKeyHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeKeyHistory.Merge(m, src)
+}
+func (m *NodeKeyHistory) XXX_Size() int {
+	return xxx_messageInfo_NodeKeyHistory.Size(m)
+}
+func (m *NodeKeyHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeKeyHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeKeyHistory proto.InternalMessageInfo
+
+func (m *NodeKeyHistory) GetKeys() []*NodeKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type NodeDelegateKey struct {
+	KeyId              string   `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	PublicKey          string   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	PublicKeyAlgorithm string   `protobuf:"bytes,3,opt,name=public_key_algorithm,json=publicKeyAlgorithm,proto3" json:"public_key_algorithm,omitempty"`
+	Methods            []string `protobuf:"bytes,4,rep,name=methods,proto3" json:"methods,omitempty"`
+	// 0 if key does not expire
+	ExpiryBlock          int64    `protobuf:"varint,5,opt,name=expiry_block,json=expiryBlock,proto3" json:"expiry_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeDelegateKey) Reset()         { *m = NodeDelegateKey{} }
+func (m *NodeDelegateKey) String() string { return proto.CompactTextString(m) }
+func (*NodeDelegateKey) ProtoMessage()    {}
+func (*NodeDelegateKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{48}
+}
+
+func (m *NodeDelegateKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeDelegateKey.Unmarshal(m, b)
+}
+func (m *NodeDelegateKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeDelegateKey.Marshal(b, m, deterministic)
+}
+func (m *NodeDelegateKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDelegateKey.Merge(m, src)
+}
+func (m *NodeDelegateKey) XXX_Size() int {
+	return xxx_messageInfo_NodeDelegateKey.Size(m)
+}
+func (m *NodeDelegateKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDelegateKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDelegateKey proto.InternalMessageInfo
+
+func (m *NodeDelegateKey) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *NodeDelegateKey) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *NodeDelegateKey) GetPublicKeyAlgorithm() string {
+	if m != nil {
+		return m.PublicKeyAlgorithm
+	}
+	return ""
+}
+
+func (m *NodeDelegateKey) GetMethods() []string {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+func (m *NodeDelegateKey) GetExpiryBlock() int64 {
+	if m != nil {
+		return m.ExpiryBlock
+	}
+	return 0
+}
+
+type NodeDelegateKeyList struct {
+	Keys                 []*NodeDelegateKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *NodeDelegateKeyList) Reset()         { *m = NodeDelegateKeyList{} }
+func (m *NodeDelegateKeyList) String() string { return proto.CompactTextString(m) }
+func (*NodeDelegateKeyList) ProtoMessage()    {}
+func (*NodeDelegateKeyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{49}
+}
+
+func (m *NodeDelegateKeyList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeDelegateKeyList.Unmarshal(m, b)
+}
+func (m *NodeDelegateKeyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeDelegateKeyList.Marshal(b, m, deterministic)
+}
+func (m *NodeDelegateKeyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDelegateKeyList.Merge(m, src)
+}
+func (m *NodeDelegateKeyList) XXX_Size() int {
+	return xxx_messageInfo_NodeDelegateKeyList.Size(m)
+}
+func (m *NodeDelegateKeyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDelegateKeyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDelegateKeyList proto.InternalMessageInfo
+
+func (m *NodeDelegateKeyList) GetKeys() []*NodeDelegateKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type OrganizationGroup struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	NodeIdList           []string `protobuf:"bytes,2,rep,name=node_id_list,json=nodeIdList,proto3" json:"node_id_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrganizationGroup) Reset()         { *m = OrganizationGroup{} }
+func (m *OrganizationGroup) String() string { return proto.CompactTextString(m) }
+func (*OrganizationGroup) ProtoMessage()    {}
+func (*OrganizationGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{50}
+}
+
+func (m *OrganizationGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationGroup.Unmarshal(m, b)
+}
+func (m *OrganizationGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationGroup.Marshal(b, m, deterministic)
+}
+func (m *OrganizationGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationGroup.Merge(m, src)
+}
+func (m *OrganizationGroup) XXX_Size() int {
+	return xxx_messageInfo_OrganizationGroup.Size(m)
+}
+func (m *OrganizationGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationGroup proto.InternalMessageInfo
+
+func (m *OrganizationGroup) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *OrganizationGroup) GetNodeIdList() []string {
+	if m != nil {
+		return m.NodeIdList
+	}
+	return nil
+}
+
+type TokenTransferPolicy struct {
+	// Transfer between any nodes is allowed when policy is not enabled
+	Enabled              bool                 `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AllowSameProxy       bool                 `protobuf:"varint,2,opt,name=allow_same_proxy,json=allowSameProxy,proto3" json:"allow_same_proxy,omitempty"`
+	OrganizationGroups   []*OrganizationGroup `protobuf:"bytes,3,rep,name=organization_groups,json=organizationGroups,proto3" json:"organization_groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TokenTransferPolicy) Reset()         { *m = TokenTransferPolicy{} }
+func (m *TokenTransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*TokenTransferPolicy) ProtoMessage()    {}
+func (*TokenTransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{51}
+}
+
+func (m *TokenTransferPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenTransferPolicy.Unmarshal(m, b)
+}
+func (m *TokenTransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenTransferPolicy.Marshal(b, m, deterministic)
+}
+func (m *TokenTransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTransferPolicy.Merge(m, src)
+}
+func (m *TokenTransferPolicy) XXX_Size() int {
+	return xxx_messageInfo_TokenTransferPolicy.Size(m)
+}
+func (m *TokenTransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTransferPolicy proto.InternalMessageInfo
+
+func (m *TokenTransferPolicy) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *TokenTransferPolicy) GetAllowSameProxy() bool {
+	if m != nil {
+		return m.AllowSameProxy
+	}
+	return false
+}
+
+func (m *TokenTransferPolicy) GetOrganizationGroups() []*OrganizationGroup {
+	if m != nil {
+		return m.OrganizationGroups
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*KeyVersions)(nil), "KeyVersions")
 	proto.RegisterType((*NodeDetail)(nil), "NodeDetail")
@@ -1997,6 +3126,7 @@ func init() {
 	proto.RegisterType((*Request)(nil), "Request")
 	proto.RegisterType((*DataRequest)(nil), "DataRequest")
 	proto.RegisterType((*Response)(nil), "Response")
+	proto.RegisterType((*ResponseHistory)(nil), "ResponseHistory")
 	proto.RegisterType((*ReportList)(nil), "ReportList")
 	proto.RegisterType((*Report)(nil), "Report")
 	proto.RegisterType((*Accessor)(nil), "Accessor")
@@ -2012,120 +3142,205 @@ func init() {
 	proto.RegisterType((*AccessorInGroup)(nil), "AccessorInGroup")
 	proto.RegisterType((*Token)(nil), "Token")
 	proto.RegisterType((*TokenPrice)(nil), "TokenPrice")
+	proto.RegisterType((*PriceFuncScheduleEntry)(nil), "PriceFuncScheduleEntry")
+	proto.RegisterType((*PriceFuncSchedule)(nil), "PriceFuncSchedule")
+	proto.RegisterType((*TokenDecimals)(nil), "TokenDecimals")
+	proto.RegisterType((*FeePolicy)(nil), "FeePolicy")
 	proto.RegisterType((*ReferenceGroup)(nil), "ReferenceGroup")
 	proto.RegisterType((*IdPInRefGroup)(nil), "IdPInRefGroup")
 	proto.RegisterType((*IdentityInRefGroup)(nil), "IdentityInRefGroup")
+	proto.RegisterType((*PendingRegisterIdentity)(nil), "PendingRegisterIdentity")
 	proto.RegisterType((*AllowedModeList)(nil), "AllowedModeList")
 	proto.RegisterType((*AllowedMinIalForRegisterIdentityAtFirstIdp)(nil), "AllowedMinIalForRegisterIdentityAtFirstIdp")
+	proto.RegisterType((*VersionPruningPolicy)(nil), "VersionPruningPolicy")
+	proto.RegisterType((*MinimumSignatureScheme)(nil), "MinimumSignatureScheme")
+	proto.RegisterType((*GovernanceKey)(nil), "GovernanceKey")
+	proto.RegisterType((*Governance)(nil), "Governance")
+	proto.RegisterType((*NDIDProposal)(nil), "NDIDProposal")
+	proto.RegisterType((*NodeKey)(nil), "NodeKey")
+	proto.RegisterType((*NodeKeyHistory)(nil), "NodeKeyHistory")
+	proto.RegisterType((*NodeDelegateKey)(nil), "NodeDelegateKey")
+	proto.RegisterType((*NodeDelegateKeyList)(nil), "NodeDelegateKeyList")
+	proto.RegisterType((*OrganizationGroup)(nil), "OrganizationGroup")
+	proto.RegisterType((*TokenTransferPolicy)(nil), "TokenTransferPolicy")
 }
 
 func init() { proto.RegisterFile("protos/data/data.proto", fileDescriptor_492be2f0ffbab25c) }
 
 var fileDescriptor_492be2f0ffbab25c = []byte{
-	// 1667 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4d, 0x6f, 0x1c, 0x45,
-	0x13, 0xd6, 0xec, 0xf7, 0xd6, 0xda, 0xeb, 0x78, 0xec, 0x38, 0xf3, 0xbe, 0x31, 0xc4, 0x1e, 0x42,
-	0xb2, 0x09, 0xc9, 0x06, 0x39, 0x42, 0x8a, 0xc4, 0x01, 0x6d, 0x62, 0x42, 0x96, 0xe0, 0xc4, 0x19,
-	0x1b, 0x2e, 0x20, 0x8d, 0x3a, 0x33, 0x6d, 0x6f, 0x2b, 0xf3, 0xe5, 0xee, 0x19, 0x27, 0xbe, 0x73,
-	0x44, 0xe2, 0x7f, 0x70, 0xe0, 0x07, 0x20, 0x71, 0x40, 0xe2, 0xc4, 0x1f, 0xe2, 0x8a, 0xba, 0xba,
-	0x7b, 0x66, 0xd6, 0x8e, 0x6d, 0xb8, 0xac, 0xa6, 0x9f, 0xaa, 0x9e, 0x9e, 0xae, 0xaa, 0xe7, 0xa9,
-	0x5a, 0x58, 0xcb, 0x78, 0x9a, 0xa7, 0xe2, 0x41, 0x48, 0x72, 0x82, 0x3f, 0x63, 0x04, 0xdc, 0x3b,
-	0x30, 0x78, 0x4e, 0x4f, 0xbe, 0xa3, 0x5c, 0xb0, 0x34, 0x11, 0xf6, 0xff, 0xa1, 0x77, 0xac, 0x9f,
-	0x1d, 0x6b, 0xa3, 0x39, 0x6a, 0x7a, 0xe5, 0xda, 0xfd, 0xa9, 0x09, 0xf0, 0x22, 0x0d, 0xe9, 0x36,
-	0xcd, 0x09, 0x8b, 0xec, 0x0f, 0x00, 0xb2, 0xe2, 0x75, 0xc4, 0x02, 0xff, 0x0d, 0x3d, 0x71, 0xac,
-	0x0d, 0x6b, 0xd4, 0xf7, 0xfa, 0x0a, 0x79, 0x4e, 0x4f, 0xec, 0xbb, 0xb0, 0x1c, 0x13, 0x91, 0x53,
-	0xee, 0xd7, 0xbc, 0x1a, 0xe8, 0xb5, 0xa4, 0x0c, 0xbb, 0xa5, 0xef, 0x75, 0xe8, 0x27, 0x69, 0x48,
-	0xfd, 0x84, 0xc4, 0xd4, 0x69, 0xa2, 0x4f, 0x4f, 0x02, 0x2f, 0x48, 0x4c, 0x6d, 0x1b, 0x5a, 0x3c,
-	0x8d, 0xa8, 0xd3, 0x42, 0x1c, 0x9f, 0xed, 0x6b, 0xd0, 0x8d, 0xc9, 0x3b, 0x9f, 0x91, 0xc8, 0x69,
-	0x6f, 0x58, 0x23, 0xcb, 0xeb, 0xc4, 0xe4, 0xdd, 0x94, 0x44, 0xc6, 0x40, 0x48, 0xe4, 0x74, 0x4a,
-	0xc3, 0x84, 0x44, 0xf6, 0x0a, 0x34, 0xe2, 0x23, 0xa7, 0xbb, 0xd1, 0x1c, 0x0d, 0xb6, 0x9a, 0xe3,
-	0x9d, 0x57, 0x5e, 0x23, 0x3e, 0xb2, 0xd7, 0xa0, 0x43, 0x82, 0x9c, 0x1d, 0x53, 0xa7, 0xb7, 0x61,
-	0x8d, 0x7a, 0x9e, 0x5e, 0xd9, 0x2e, 0x2c, 0x66, 0x3c, 0x7d, 0x77, 0xe2, 0xe3, 0x57, 0xb1, 0xd0,
-	0xe9, 0xe3, 0xd9, 0x03, 0x04, 0x65, 0x08, 0xa6, 0xa1, 0xbd, 0x09, 0x0b, 0xca, 0x27, 0x48, 0x93,
-	0x03, 0x76, 0xe8, 0x40, 0xcd, 0xe5, 0x09, 0x42, 0xf6, 0x0f, 0x70, 0x4f, 0x14, 0x59, 0x96, 0xf2,
-	0x9c, 0x86, 0x3e, 0xa7, 0x47, 0x05, 0x15, 0xb9, 0x1f, 0x53, 0x21, 0xc8, 0x21, 0xf5, 0x65, 0x0e,
-	0xfc, 0x82, 0x47, 0x7e, 0x7e, 0x92, 0x51, 0x3f, 0x62, 0x22, 0x77, 0x06, 0x1b, 0xcd, 0x51, 0xdf,
-	0xbb, 0x55, 0xee, 0xf1, 0xd4, 0x96, 0x1d, 0xb5, 0x63, 0x9b, 0xe4, 0xe4, 0x5b, 0x1e, 0xed, 0x9f,
-	0x64, 0xf4, 0x1b, 0x26, 0x72, 0x77, 0x04, 0x8d, 0x9d, 0x57, 0xf6, 0x10, 0x1a, 0x2c, 0xd3, 0xd1,
-	0x6f, 0xb0, 0x4c, 0x46, 0x4b, 0x6e, 0xc6, 0x48, 0x37, 0x3d, 0x7c, 0x76, 0x5d, 0xe8, 0x4e, 0xc3,
-	0x5d, 0xb9, 0x49, 0xc6, 0xc7, 0xdc, 0xc9, 0xc2, 0xd3, 0x3a, 0x09, 0x5e, 0xc7, 0xfd, 0x1c, 0x16,
-	0x65, 0xb4, 0x45, 0x46, 0x02, 0x7c, 0xbd, 0x7d, 0x17, 0x20, 0x31, 0x80, 0xaa, 0x85, 0xc1, 0x16,
-	0x8c, 0x4b, 0x1f, 0xaf, 0x66, 0x75, 0x7f, 0x69, 0x40, 0xbf, 0xb4, 0xd8, 0xeb, 0xd0, 0x2f, 0x6d,
-	0xa6, 0x2e, 0x4a, 0xc0, 0xde, 0x80, 0x41, 0x48, 0x45, 0xc0, 0x59, 0x96, 0xb3, 0x34, 0xd1, 0x15,
-	0x51, 0x87, 0x6a, 0x59, 0x69, 0xce, 0x65, 0xe5, 0x7b, 0xf8, 0x84, 0x44, 0x51, 0xfa, 0x96, 0x86,
-	0x3e, 0x0b, 0x69, 0x92, 0xb3, 0x03, 0x46, 0xb9, 0x1f, 0xa4, 0x45, 0x92, 0xfb, 0x2c, 0xf1, 0x39,
-	0x3d, 0xa0, 0x9c, 0x26, 0x01, 0xf5, 0x0f, 0x79, 0x5a, 0x64, 0x58, 0x2f, 0x6d, 0xef, 0x96, 0xde,
-	0x32, 0x2d, 0x77, 0x3c, 0x91, 0x1b, 0xa6, 0x89, 0x67, 0xdc, 0xbf, 0x92, 0xde, 0xf6, 0x0c, 0xb6,
-	0xcc, 0xcb, 0xd5, 0x71, 0xff, 0xea, 0x8c, 0x36, 0x9e, 0x71, 0x4f, 0xef, 0x9c, 0xe0, 0xc6, 0x4b,
-	0x4e, 0x72, 0xbf, 0x80, 0xe5, 0x3d, 0xca, 0x8f, 0x59, 0xa0, 0x89, 0xa4, 0xa3, 0xdd, 0x13, 0x0a,
-	0x34, 0xb1, 0x1e, 0x8e, 0xe7, 0xbc, 0xbc, 0xd2, 0xee, 0xfe, 0x66, 0xc1, 0xe2, 0x9c, 0x4d, 0x52,
-	0x51, 0x5b, 0x55, 0x62, 0x31, 0xe4, 0x1a, 0x51, 0xa5, 0x6a, 0xcc, 0xc8, 0x30, 0x1d, 0x73, 0x8d,
-	0x21, 0xc9, 0x6e, 0xc0, 0x00, 0x0b, 0x52, 0x04, 0x33, 0x1a, 0x13, 0xcd, 0x41, 0x90, 0xd0, 0x1e,
-	0x22, 0xf6, 0x18, 0x56, 0x6a, 0x0e, 0xbe, 0x16, 0x05, 0x4d, 0xca, 0xe5, 0xca, 0x51, 0x2b, 0x49,
-	0x2d, 0x89, 0xed, 0x7a, 0x12, 0xdd, 0x11, 0x0c, 0x27, 0x59, 0xc6, 0xd3, 0x63, 0xaa, 0xaf, 0x50,
-	0xf3, 0xb4, 0xe6, 0x3c, 0xb7, 0x61, 0x7d, 0x9f, 0xc5, 0xf4, 0x65, 0x91, 0x3f, 0x8e, 0xd2, 0xe0,
-	0x8d, 0x47, 0x0f, 0x99, 0x54, 0x0d, 0x15, 0xde, 0xfc, 0xc4, 0xbe, 0x09, 0xc3, 0x9c, 0xc5, 0xd4,
-	0x4f, 0x8b, 0xdc, 0x7f, 0x2d, 0x3d, 0x70, 0x7f, 0xd3, 0x5b, 0xc8, 0x6b, 0xbb, 0xdc, 0x27, 0xd0,
-	0xde, 0x95, 0x94, 0x3c, 0xcb, 0x69, 0xeb, 0x2c, 0xa7, 0xd7, 0xa0, 0xa3, 0xd9, 0xac, 0x42, 0xa4,
-	0x57, 0xee, 0x2d, 0x18, 0x3e, 0xa6, 0x33, 0x96, 0x84, 0xd2, 0x0f, 0xf3, 0xb5, 0x0a, 0x6d, 0xf9,
-	0x1e, 0xa1, 0x59, 0xa4, 0x16, 0xee, 0xef, 0x2d, 0xe8, 0x6a, 0xd2, 0xca, 0x9c, 0x18, 0xca, 0x57,
-	0x39, 0xd1, 0xc8, 0x34, 0x44, 0xa1, 0x62, 0x89, 0xcf, 0xc2, 0x4c, 0x53, 0xb5, 0x13, 0xb3, 0x64,
-	0x1a, 0x66, 0xc6, 0x20, 0x15, 0xac, 0xa9, 0x15, 0x8c, 0x25, 0x13, 0x2d, 0x6d, 0x72, 0x07, 0x89,
-	0x30, 0xea, 0xca, 0x20, 0x35, 0xef, 0x36, 0x2c, 0x99, 0x93, 0xe4, 0xd5, 0xd3, 0x22, 0xc7, 0x98,
-	0x37, 0xbd, 0xa1, 0x86, 0xf7, 0x15, 0x6a, 0x7f, 0x08, 0x03, 0x16, 0x66, 0x3e, 0x0b, 0x95, 0xdc,
-	0x74, 0xf0, 0xd3, 0xfb, 0x2c, 0xcc, 0xa6, 0x21, 0x5e, 0xea, 0x11, 0x60, 0x22, 0x4b, 0xa9, 0x42,
-	0x2f, 0x25, 0x99, 0x0b, 0x63, 0x29, 0x3f, 0xfa, 0x6e, 0xde, 0x52, 0x58, 0x2d, 0x70, 0xe7, 0xa7,
-	0xb0, 0x7a, 0x5a, 0xdf, 0x66, 0x44, 0xcc, 0x50, 0x56, 0xfb, 0x9e, 0xcd, 0xe7, 0x84, 0xec, 0x19,
-	0x11, 0x33, 0x7b, 0x0c, 0x8b, 0x9c, 0x8a, 0x2c, 0x4d, 0x84, 0x16, 0xbf, 0x3e, 0x9e, 0xd3, 0x1f,
-	0x7b, 0x1a, 0xf5, 0x16, 0x8c, 0x1d, 0x4f, 0x90, 0xa9, 0x89, 0x52, 0x41, 0x43, 0x14, 0xda, 0x9e,
-	0xa7, 0x57, 0xb2, 0x75, 0xc8, 0x4b, 0x87, 0xb2, 0x0c, 0x9c, 0x01, 0x9a, 0x7a, 0x08, 0xbc, 0x2c,
-	0x72, 0xdb, 0x81, 0x6e, 0x56, 0xf0, 0x2c, 0x15, 0xd4, 0x59, 0xc0, 0x2f, 0x31, 0x4b, 0x99, 0xbf,
-	0xf4, 0x6d, 0x42, 0xb9, 0xb3, 0x88, 0xb8, 0x5a, 0x48, 0xf1, 0x8c, 0xd3, 0x90, 0x3a, 0x43, 0xa4,
-	0x35, 0x3e, 0xcb, 0x03, 0x0a, 0x41, 0x95, 0x04, 0x38, 0x4b, 0x18, 0xd7, 0x5e, 0x21, 0x28, 0x72,
-	0xdb, 0xde, 0x82, 0xab, 0x01, 0xa7, 0x44, 0xca, 0x96, 0xaa, 0x41, 0x7f, 0x46, 0xd9, 0xe1, 0x2c,
-	0x77, 0xae, 0xa0, 0xe3, 0x8a, 0x31, 0x62, 0x2d, 0x3e, 0x43, 0x93, 0xfd, 0x3f, 0xe8, 0x05, 0x33,
-	0x82, 0xb9, 0x77, 0x96, 0xd5, 0x57, 0xe1, 0x7a, 0x1a, 0xba, 0x7f, 0x5b, 0x30, 0xa8, 0xc5, 0xf9,
-	0x32, 0x5e, 0xaf, 0x03, 0x10, 0x51, 0xa6, 0xb3, 0x81, 0xe9, 0xec, 0x11, 0xa1, 0xb3, 0x79, 0x15,
-	0x3a, 0x58, 0x48, 0x02, 0xeb, 0xa8, 0xe9, 0xb5, 0x65, 0x1d, 0x09, 0x49, 0x64, 0x93, 0xaa, 0x8c,
-	0x70, 0x12, 0x0b, 0x95, 0x29, 0x4d, 0x64, 0x6d, 0xda, 0x45, 0x0b, 0x26, 0xea, 0x3e, 0xac, 0x90,
-	0x44, 0xbc, 0xa5, 0x5c, 0x2a, 0x63, 0x75, 0x5a, 0x1b, 0x4f, 0xbb, 0x62, 0x4c, 0x13, 0x73, 0xea,
-	0x67, 0x70, 0x8d, 0xd3, 0x80, 0xb2, 0x63, 0x1a, 0xaa, 0x16, 0x77, 0xc0, 0xd3, 0xb8, 0x5e, 0x6f,
-	0xab, 0xc6, 0x2c, 0x2f, 0xfa, 0x94, 0xa7, 0x31, 0x36, 0xb3, 0x3f, 0x2c, 0xe8, 0x99, 0xcc, 0xdb,
-	0x57, 0xa0, 0x29, 0xab, 0xdc, 0xc2, 0x2a, 0x97, 0x8f, 0x12, 0x91, 0x84, 0x68, 0x28, 0x84, 0x90,
-	0x48, 0xd6, 0x83, 0xc8, 0x49, 0x5e, 0x08, 0xad, 0x55, 0x7a, 0x25, 0x9b, 0x8f, 0x60, 0x87, 0x09,
-	0xc9, 0x0b, 0x6e, 0x46, 0x86, 0x0a, 0x90, 0x31, 0x51, 0x0c, 0x40, 0x86, 0xf4, 0xbd, 0x36, 0x16,
-	0xbf, 0xcc, 0xf1, 0x31, 0x89, 0x58, 0x88, 0xe4, 0xea, 0xa8, 0xf9, 0x03, 0x01, 0x4d, 0x2f, 0x65,
-	0xac, 0xde, 0xdb, 0x45, 0x97, 0x21, 0xc2, 0x7b, 0x06, 0x75, 0x1f, 0x00, 0x78, 0x54, 0x36, 0x5c,
-	0x0c, 0xc4, 0x26, 0x74, 0x39, 0xae, 0x8c, 0xa0, 0x77, 0xc7, 0xca, 0xea, 0x19, 0xdc, 0xfd, 0x1a,
-	0x3a, 0x0a, 0x92, 0xb7, 0x89, 0x69, 0x3e, 0x4b, 0x4d, 0x92, 0xf5, 0x4a, 0x96, 0x69, 0xc6, 0x59,
-	0x40, 0xf5, 0xcd, 0xd5, 0x42, 0x96, 0xa9, 0x0c, 0xad, 0xbe, 0x39, 0x3e, 0xbb, 0xbf, 0x5a, 0xd0,
-	0x9b, 0x04, 0x01, 0x15, 0x22, 0xe5, 0x52, 0xcd, 0x89, 0x7e, 0xae, 0x0a, 0x07, 0x0c, 0x34, 0x0d,
-	0xed, 0x8f, 0x60, 0xb1, 0x74, 0x90, 0xf3, 0x87, 0xd6, 0xbb, 0x05, 0x03, 0xca, 0x21, 0x43, 0x56,
-	0x4a, 0xe9, 0x54, 0x9b, 0xe1, 0xd4, 0xa9, 0xcb, 0xc6, 0x54, 0x4d, 0x71, 0x95, 0x90, 0xb7, 0xe6,
-	0xfa, 0x76, 0xc9, 0xb5, 0x76, 0x8d, 0x6b, 0xee, 0x1d, 0x80, 0x1d, 0x71, 0xb4, 0x4d, 0x05, 0x46,
-	0xeb, 0x7a, 0x5d, 0x4f, 0x07, 0x5b, 0xed, 0xb1, 0x54, 0x5a, 0x23, 0xab, 0x3f, 0x5a, 0xd0, 0x92,
-	0xeb, 0xf7, 0x14, 0x46, 0x6d, 0x9e, 0xd1, 0x92, 0x9d, 0x94, 0x52, 0xfe, 0xde, 0x21, 0x62, 0x15,
-	0xda, 0x07, 0x8c, 0x8b, 0x5c, 0x7f, 0xa3, 0x5a, 0xc8, 0x78, 0x68, 0xe9, 0xd4, 0xad, 0xa4, 0x5d,
-	0xb5, 0x92, 0xd4, 0xb4, 0x92, 0x87, 0x30, 0xd0, 0x3d, 0x0b, 0x3f, 0xf9, 0xe6, 0x99, 0x96, 0xdd,
-	0x33, 0x2d, 0xbb, 0xd6, 0xac, 0xff, 0xb2, 0xa0, 0x6b, 0x3a, 0xdd, 0x25, 0x74, 0xae, 0x09, 0x7c,
-	0x63, 0x4e, 0xe0, 0xcf, 0x6d, 0x09, 0xe7, 0x45, 0x5c, 0x92, 0xa0, 0x10, 0x19, 0x4d, 0x42, 0x1a,
-	0xea, 0xfe, 0x5b, 0x01, 0xf6, 0x23, 0x70, 0xaa, 0xb1, 0xb4, 0x1c, 0xcc, 0xea, 0x1c, 0x5d, 0x2b,
-	0xed, 0x73, 0x33, 0xa1, 0x7b, 0x1f, 0x86, 0xe5, 0xe0, 0x61, 0xf2, 0xd6, 0x92, 0x01, 0x2f, 0x4b,
-	0x7c, 0xb2, 0x87, 0x89, 0x43, 0xd0, 0xfd, 0xd3, 0x82, 0x8e, 0x02, 0xe6, 0xe7, 0xce, 0x7a, 0x9e,
-	0xfe, 0xfb, 0xa5, 0xe7, 0xa3, 0xd8, 0x3a, 0x1d, 0xc5, 0x8b, 0x6e, 0xd7, 0xbe, 0xe8, 0x76, 0xb5,
-	0x68, 0x76, 0xe6, 0x06, 0x91, 0x4d, 0xe8, 0x78, 0x97, 0x4c, 0xcf, 0x9b, 0xf2, 0xa2, 0x17, 0xbb,
-	0xb8, 0xd0, 0x9d, 0x44, 0xd1, 0xc5, 0x3e, 0x0f, 0x60, 0xc9, 0x70, 0x78, 0x9a, 0xa8, 0xb9, 0x74,
-	0x1d, 0xfa, 0x86, 0x69, 0x66, 0xd8, 0xa8, 0x00, 0xf7, 0x06, 0xb4, 0xf7, 0xd3, 0x37, 0x54, 0x8d,
-	0x5b, 0x31, 0xb6, 0x28, 0x45, 0x0e, 0xbd, 0x72, 0x5d, 0x00, 0x74, 0xd8, 0x45, 0xe1, 0x28, 0xe5,
-	0xc4, 0xaa, 0xc9, 0x89, 0xcb, 0x60, 0x78, 0x6a, 0x18, 0x7e, 0x08, 0xa0, 0xa6, 0xdf, 0x9c, 0x95,
-	0xc5, 0xbd, 0x32, 0x36, 0x93, 0x17, 0x4e, 0xb4, 0xe8, 0xe8, 0xd5, 0xdc, 0x6c, 0x17, 0x5a, 0x2c,
-	0xcc, 0x04, 0xf6, 0x21, 0x39, 0xbe, 0x4e, 0xc3, 0xdd, 0x9a, 0x27, 0xda, 0xdc, 0x9f, 0x2d, 0x58,
-	0x9c, 0xc3, 0xcf, 0x2f, 0x0c, 0xd3, 0x8b, 0xe5, 0xeb, 0x4c, 0x2f, 0xbe, 0x5d, 0x0f, 0x46, 0x53,
-	0x0f, 0x0c, 0x26, 0x62, 0xb5, 0xb8, 0x18, 0xa1, 0x68, 0x55, 0x42, 0x71, 0xde, 0x3c, 0x2a, 0xc0,
-	0x3e, 0x7b, 0xaf, 0x4b, 0xfe, 0xc2, 0xdc, 0x86, 0xa5, 0xda, 0x9f, 0x03, 0x6c, 0x9f, 0x4a, 0x7c,
-	0x86, 0x15, 0x8c, 0xbd, 0xf3, 0x1c, 0x11, 0x72, 0x3f, 0x86, 0xa5, 0x89, 0xfa, 0xcb, 0xb0, 0x63,
-	0x06, 0x4a, 0x73, 0x5d, 0xab, 0xba, 0xae, 0xfb, 0x25, 0xdc, 0x35, 0x6e, 0xc8, 0x89, 0xa7, 0x29,
-	0x3f, 0x3d, 0x05, 0x4f, 0xf2, 0xa7, 0x52, 0xc0, 0x6a, 0x83, 0x63, 0x25, 0x90, 0x9a, 0x49, 0xaf,
-	0x3b, 0xf8, 0x4f, 0xff, 0xe1, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7c, 0xcc, 0x14, 0xf1, 0x03,
-	0x10, 0x00, 0x00,
+	// 2784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4b, 0x6f, 0x1c, 0xc7,
+	0x11, 0xc6, 0xec, 0x7b, 0x6b, 0xc9, 0xe5, 0x72, 0x48, 0x53, 0x63, 0x5b, 0x8e, 0xa9, 0xf1, 0x8b,
+	0x7e, 0xad, 0x12, 0x39, 0x01, 0x0c, 0x18, 0x41, 0xb2, 0x26, 0x4d, 0x7b, 0x6d, 0xc9, 0xa2, 0x47,
+	0x8c, 0x2f, 0x0e, 0x30, 0x68, 0xed, 0xf4, 0xee, 0x36, 0x34, 0x33, 0x3d, 0xea, 0x9e, 0x25, 0xb5,
+	0x01, 0x72, 0x0b, 0x10, 0x20, 0x97, 0x9c, 0x73, 0xc9, 0x0f, 0xf0, 0x29, 0xa7, 0x1c, 0x7c, 0xcb,
+	0x35, 0x7f, 0x20, 0x40, 0x80, 0xfc, 0x88, 0xfc, 0x80, 0x00, 0x41, 0x57, 0x77, 0xcf, 0x63, 0x29,
+	0x4a, 0xca, 0x25, 0x17, 0x62, 0xba, 0xaa, 0x7a, 0xbb, 0xbb, 0x1e, 0x5f, 0x3d, 0x08, 0x07, 0x99,
+	0xe0, 0x39, 0x97, 0xb7, 0x23, 0x92, 0x13, 0xfc, 0x33, 0x46, 0x82, 0xff, 0x1d, 0x0c, 0xbe, 0xa2,
+	0xeb, 0x6f, 0xa9, 0x90, 0x8c, 0xa7, 0xd2, 0x7d, 0x05, 0x7a, 0x17, 0xe6, 0xdb, 0x73, 0x0e, 0x9b,
+	0x47, 0xcd, 0xa0, 0x58, 0xbb, 0x3f, 0x86, 0xfd, 0x4c, 0xac, 0x52, 0x1a, 0x85, 0x73, 0x26, 0x64,
+	0x1e, 0x1a, 0x86, 0xd7, 0x38, 0x74, 0x8e, 0x9a, 0x81, 0xab, 0x79, 0xa7, 0x8a, 0x65, 0x7e, 0xce,
+	0xff, 0x4f, 0x13, 0xe0, 0x6b, 0x1e, 0xd1, 0x13, 0x9a, 0x13, 0x16, 0xbb, 0xaf, 0x01, 0x64, 0xab,
+	0x87, 0x31, 0x9b, 0x85, 0x8f, 0xe8, 0xda, 0x73, 0x0e, 0x9d, 0xa3, 0x7e, 0xd0, 0xd7, 0x94, 0xaf,
+	0xe8, 0xda, 0x7d, 0x0f, 0x76, 0x13, 0x22, 0x73, 0x2a, 0xc2, 0x8a, 0x54, 0x03, 0xa5, 0x76, 0x34,
+	0xe3, 0xac, 0x90, 0x7d, 0x15, 0xfa, 0x29, 0x8f, 0x68, 0x98, 0x92, 0x84, 0x7a, 0x4d, 0x94, 0xe9,
+	0x29, 0xc2, 0xd7, 0x24, 0xa1, 0xae, 0x0b, 0x2d, 0xc1, 0x63, 0xea, 0xb5, 0x90, 0x8e, 0xdf, 0xee,
+	0x0d, 0xe8, 0x26, 0xe4, 0x49, 0xc8, 0x48, 0xec, 0xb5, 0x0f, 0x9d, 0x23, 0x27, 0xe8, 0x24, 0xe4,
+	0xc9, 0x94, 0xc4, 0x96, 0x41, 0x48, 0xec, 0x75, 0x0a, 0xc6, 0x84, 0xc4, 0xee, 0x1e, 0x34, 0x92,
+	0xc7, 0x5e, 0xf7, 0xb0, 0x79, 0x34, 0xb8, 0xd3, 0x1c, 0xdf, 0xfb, 0x26, 0x68, 0x24, 0x8f, 0xdd,
+	0x03, 0xe8, 0x90, 0x59, 0xce, 0x2e, 0xa8, 0xd7, 0x3b, 0x74, 0x8e, 0x7a, 0x81, 0x59, 0xb9, 0x3e,
+	0x6c, 0x67, 0x82, 0x3f, 0x59, 0x87, 0x78, 0x2b, 0x16, 0x79, 0x7d, 0x3c, 0x7b, 0x80, 0x44, 0xa5,
+	0x82, 0x69, 0xe4, 0xde, 0x82, 0x2d, 0x2d, 0x33, 0xe3, 0xe9, 0x9c, 0x2d, 0x3c, 0xa8, 0x88, 0x1c,
+	0x23, 0xc9, 0xfd, 0x35, 0x7c, 0x20, 0x57, 0x59, 0xc6, 0x45, 0x4e, 0xa3, 0x50, 0xd0, 0xc7, 0x2b,
+	0x2a, 0xf3, 0x30, 0xa1, 0x52, 0x92, 0x05, 0x0d, 0x95, 0xd5, 0xc2, 0x95, 0x88, 0xc3, 0x7c, 0x9d,
+	0xd1, 0x30, 0x66, 0x32, 0xf7, 0x06, 0x87, 0xcd, 0xa3, 0x7e, 0xf0, 0x76, 0xb1, 0x27, 0xd0, 0x5b,
+	0xee, 0xe9, 0x1d, 0x27, 0x24, 0x27, 0xbf, 0x12, 0xf1, 0xf9, 0x3a, 0xa3, 0x77, 0x99, 0xcc, 0xd1,
+	0x80, 0x85, 0x66, 0x43, 0x12, 0x2f, 0xb8, 0x60, 0xf9, 0x32, 0xf1, 0xb6, 0xf0, 0x22, 0x6e, 0x61,
+	0x89, 0x89, 0xe5, 0xb8, 0x3f, 0x87, 0x57, 0xaf, 0x98, 0xa4, 0xb2, 0x71, 0x1b, 0x37, 0x7a, 0x1b,
+	0xc6, 0x29, 0xb6, 0xfb, 0x47, 0xd0, 0xb8, 0xf7, 0x8d, 0x3b, 0x84, 0x06, 0xcb, 0x8c, 0xb9, 0x1b,
+	0x2c, 0x53, 0xe6, 0x51, 0xb7, 0x35, 0x7e, 0x83, 0xdf, 0xbe, 0x0f, 0xdd, 0x69, 0x74, 0x86, 0xb7,
+	0xbc, 0x01, 0x5d, 0xab, 0x44, 0x07, 0x9f, 0xd7, 0x49, 0x51, 0x7f, 0xfe, 0x27, 0xb0, 0xad, 0xcc,
+	0x2b, 0x33, 0x32, 0xd3, 0xef, 0x79, 0x0f, 0x20, 0xb5, 0x04, 0xed, 0xae, 0x83, 0x3b, 0x30, 0x2e,
+	0x64, 0x82, 0x0a, 0xd7, 0xff, 0xbe, 0x01, 0xfd, 0x82, 0xe3, 0xde, 0x84, 0x7e, 0xc1, 0xb3, 0x8e,
+	0x58, 0x10, 0xdc, 0x43, 0x18, 0x44, 0x54, 0xce, 0x04, 0xcb, 0x72, 0xeb, 0xdf, 0xfd, 0xa0, 0x4a,
+	0xaa, 0xb8, 0x41, 0xb3, 0xe6, 0x06, 0xdf, 0xc1, 0xfb, 0x24, 0x8e, 0xf9, 0x25, 0x8d, 0x42, 0x16,
+	0xd1, 0x34, 0x67, 0x73, 0x46, 0x45, 0x38, 0xe3, 0xab, 0x34, 0x0f, 0x59, 0x1a, 0x0a, 0x3a, 0xa7,
+	0x82, 0xa6, 0x33, 0x1a, 0x2e, 0x04, 0x5f, 0x65, 0xe8, 0xa0, 0xed, 0xe0, 0x6d, 0xb3, 0x65, 0x5a,
+	0xec, 0x38, 0x56, 0x1b, 0xa6, 0x69, 0x60, 0xc5, 0x3f, 0x57, 0xd2, 0xee, 0x12, 0xee, 0xd8, 0x1f,
+	0xd7, 0xc7, 0xbd, 0xd0, 0x19, 0x6d, 0x3c, 0xe3, 0x03, 0xb3, 0x73, 0x82, 0x1b, 0x9f, 0x73, 0x92,
+	0xff, 0x0b, 0xd8, 0x7d, 0x40, 0xc5, 0x05, 0x9b, 0x99, 0xc8, 0x35, 0xda, 0xee, 0x49, 0x4d, 0xb4,
+	0xba, 0x1e, 0x8e, 0x6b, 0x52, 0x41, 0xc1, 0xf7, 0x7f, 0x70, 0x60, 0xbb, 0xc6, 0x53, 0xb1, 0x6f,
+	0xb8, 0xda, 0xb0, 0xa8, 0x72, 0x43, 0xd1, 0xb1, 0x61, 0xd9, 0x18, 0xd2, 0x46, 0xe7, 0x86, 0x86,
+	0x51, 0xfd, 0x3a, 0x0c, 0x30, 0x02, 0xe4, 0x6c, 0x49, 0x13, 0x62, 0x82, 0x1e, 0x14, 0xe9, 0x01,
+	0x52, 0xdc, 0x31, 0xec, 0x55, 0x04, 0x0a, 0x78, 0xd2, 0x28, 0xb0, 0x5b, 0x0a, 0x1a, 0x74, 0xaa,
+	0x18, 0xb1, 0x5d, 0x35, 0xa2, 0x7f, 0x04, 0xc3, 0x49, 0x96, 0x09, 0x7e, 0x41, 0xcd, 0x13, 0x2a,
+	0x92, 0x4e, 0x4d, 0xf2, 0x04, 0x6e, 0x9e, 0xb3, 0x84, 0xde, 0x5f, 0xe5, 0x9f, 0xc6, 0x7c, 0xf6,
+	0x28, 0xa0, 0x0b, 0xa6, 0x22, 0x41, 0xab, 0x37, 0x5f, 0xbb, 0x6f, 0xc2, 0x30, 0x67, 0x09, 0x0d,
+	0xf9, 0x2a, 0x0f, 0x1f, 0x2a, 0x09, 0xdc, 0xdf, 0x0c, 0xb6, 0xf2, 0xca, 0x2e, 0xff, 0x18, 0xda,
+	0x67, 0x0a, 0x03, 0xae, 0x82, 0x88, 0x73, 0x15, 0x44, 0x0e, 0xa0, 0x63, 0xe0, 0x43, 0xab, 0xc8,
+	0xac, 0xfc, 0xb7, 0x61, 0xf8, 0x29, 0x5d, 0xb2, 0x34, 0x52, 0x72, 0x68, 0xaf, 0x7d, 0x68, 0xab,
+	0xdf, 0x91, 0x26, 0x8a, 0xf4, 0xc2, 0xff, 0x47, 0x1b, 0xba, 0x06, 0x25, 0x94, 0x4d, 0x2c, 0xc6,
+	0x94, 0x36, 0x31, 0x94, 0x69, 0x84, 0xc8, 0xc8, 0xd2, 0x90, 0x45, 0x99, 0x09, 0xd5, 0x4e, 0xc2,
+	0xd2, 0x69, 0x94, 0x59, 0x86, 0x82, 0xcc, 0xa6, 0x81, 0x4c, 0x96, 0x4e, 0x48, 0x5c, 0xec, 0x20,
+	0xb1, 0xd7, 0x2a, 0x18, 0x0a, 0x64, 0xdf, 0x81, 0x1d, 0x7b, 0x92, 0x7a, 0x3a, 0x5f, 0xe5, 0xa8,
+	0xf3, 0x66, 0x30, 0x34, 0xe4, 0x73, 0x4d, 0x75, 0x7f, 0x04, 0x03, 0x16, 0x65, 0x21, 0x8b, 0x34,
+	0xbe, 0x75, 0xf0, 0xea, 0x7d, 0x16, 0x65, 0xd3, 0x08, 0x1f, 0xf5, 0x31, 0xa0, 0x21, 0x0b, 0x6c,
+	0x44, 0x29, 0x8d, 0xd1, 0x5b, 0x63, 0x85, 0x77, 0xe6, 0x6d, 0xc1, 0x4e, 0x54, 0x2e, 0x2c, 0xf8,
+	0x6d, 0x02, 0xea, 0x92, 0xc8, 0x25, 0xe2, 0x78, 0x3f, 0x70, 0x45, 0x0d, 0x39, 0xbf, 0x20, 0x72,
+	0xe9, 0x8e, 0x61, 0x5b, 0x50, 0x99, 0xf1, 0x54, 0x1a, 0xb4, 0xed, 0xe3, 0x39, 0xfd, 0x71, 0x60,
+	0xa8, 0xc1, 0x96, 0xe5, 0xe3, 0x09, 0xca, 0x34, 0x31, 0x97, 0x34, 0x42, 0x64, 0xef, 0x05, 0x66,
+	0xa5, 0x72, 0x95, 0x7a, 0x74, 0xa4, 0xdc, 0xc0, 0x1b, 0x20, 0xab, 0x87, 0x84, 0xfb, 0xab, 0xdc,
+	0xf5, 0xa0, 0x9b, 0xad, 0x44, 0xc6, 0x25, 0x35, 0x30, 0x6c, 0x97, 0xca, 0x7e, 0xfc, 0x32, 0xa5,
+	0xc2, 0xa0, 0xac, 0x5e, 0x28, 0xf0, 0x4c, 0x78, 0x44, 0xbd, 0x21, 0x86, 0x35, 0x7e, 0xab, 0x03,
+	0x56, 0x92, 0x6a, 0x08, 0xf0, 0x76, 0x50, 0xaf, 0xbd, 0x95, 0xa4, 0x18, 0xdb, 0xee, 0x1d, 0x78,
+	0x69, 0x26, 0x28, 0x51, 0xb0, 0xa5, 0x7d, 0x30, 0x5c, 0x52, 0xb6, 0x58, 0xe6, 0xde, 0x08, 0x05,
+	0xf7, 0x2c, 0x13, 0x7d, 0xf1, 0x0b, 0x64, 0xb9, 0x2f, 0x43, 0x6f, 0xb6, 0x24, 0x68, 0x7b, 0x6f,
+	0x57, 0xdf, 0x0a, 0xd7, 0xd3, 0xc8, 0xfd, 0x04, 0x46, 0x85, 0x52, 0x96, 0x4c, 0xe6, 0x5c, 0xac,
+	0x3d, 0x17, 0xf5, 0x32, 0x2a, 0xf4, 0xf2, 0x85, 0xa6, 0x07, 0x3b, 0xa2, 0x4e, 0x70, 0x6f, 0xc3,
+	0xbe, 0xb2, 0xee, 0x9c, 0xd2, 0x30, 0xa3, 0x22, 0xb4, 0x6c, 0x6f, 0x0f, 0xaf, 0xb2, 0xcb, 0xa2,
+	0xec, 0x94, 0xd2, 0x33, 0x2a, 0xec, 0x0f, 0xa9, 0x92, 0x40, 0x6d, 0x50, 0xc8, 0xcb, 0x2f, 0x43,
+	0x92, 0xe0, 0x0b, 0xf7, 0x51, 0x7a, 0x87, 0x45, 0xd9, 0x67, 0x48, 0x9f, 0x20, 0xd9, 0xff, 0xa1,
+	0x01, 0x83, 0x8a, 0x07, 0x3c, 0x0f, 0x71, 0x6e, 0x02, 0x10, 0x59, 0x38, 0x5a, 0x03, 0x1d, 0xad,
+	0x47, 0xa4, 0xf1, 0xb3, 0x97, 0xa0, 0x83, 0x2e, 0x2e, 0xd1, 0xc3, 0x9b, 0x41, 0x5b, 0x79, 0xb8,
+	0x54, 0x10, 0x63, 0x9d, 0x28, 0x23, 0x82, 0x24, 0x52, 0xfb, 0x90, 0x81, 0x18, 0xc3, 0x3a, 0x43,
+	0x0e, 0xba, 0xd0, 0x87, 0xb0, 0x47, 0x52, 0x79, 0x49, 0x85, 0xc2, 0xec, 0xf2, 0xb4, 0x36, 0x9e,
+	0x36, 0xb2, 0xac, 0x89, 0x3d, 0xf5, 0x67, 0x70, 0x43, 0xd0, 0x19, 0x65, 0x17, 0x34, 0xd2, 0xd9,
+	0x7e, 0x2e, 0x78, 0x52, 0x8d, 0x84, 0x7d, 0xcb, 0x56, 0x0f, 0x3d, 0x15, 0x3c, 0xc1, 0x6d, 0x37,
+	0x01, 0xac, 0x4a, 0x89, 0xf4, 0xba, 0xda, 0x01, 0xe6, 0xa8, 0xc9, 0x89, 0x74, 0xdf, 0x80, 0xed,
+	0xba, 0xfe, 0x7a, 0x1a, 0x83, 0x68, 0x55, 0x79, 0x7f, 0x73, 0xa0, 0x57, 0x68, 0x7d, 0x04, 0x4d,
+	0x15, 0xc2, 0x0e, 0x86, 0xb0, 0xfa, 0x54, 0x14, 0x15, 0xed, 0x0d, 0x4d, 0x21, 0x24, 0x56, 0xce,
+	0x2e, 0x73, 0x92, 0xaf, 0xa4, 0x01, 0x62, 0xb3, 0x52, 0x99, 0x55, 0xb2, 0x45, 0x4a, 0xf2, 0x95,
+	0xb0, 0x05, 0x58, 0x49, 0x50, 0x6a, 0xd5, 0xe1, 0x8d, 0xe1, 0xdf, 0x0f, 0xda, 0x18, 0xd9, 0xca,
+	0x81, 0x2f, 0x48, 0xcc, 0xa2, 0x90, 0x99, 0x2a, 0xac, 0x1f, 0xf4, 0x90, 0x60, 0xb0, 0x43, 0x33,
+	0xcb, 0xdf, 0xed, 0xa2, 0xc8, 0x10, 0xc9, 0x0f, 0x2c, 0xd5, 0x97, 0xb0, 0xb3, 0xe1, 0x81, 0x16,
+	0xb8, 0x79, 0x6a, 0xec, 0x6f, 0x56, 0x2a, 0xdd, 0xd4, 0x62, 0x41, 0xe3, 0xdb, 0xe0, 0x61, 0x25,
+	0x06, 0xde, 0x82, 0x5e, 0xe1, 0x9f, 0xea, 0x89, 0xb5, 0xc0, 0x2f, 0x58, 0xfe, 0x6d, 0x80, 0x80,
+	0xaa, 0x12, 0x06, 0x2d, 0x71, 0x0b, 0xba, 0x02, 0x57, 0x36, 0x45, 0x76, 0xc7, 0x9a, 0x1b, 0x58,
+	0xba, 0xff, 0x2f, 0x07, 0x3a, 0x9a, 0xa6, 0x6e, 0x97, 0xd0, 0x7c, 0xc9, 0xad, 0x77, 0x9a, 0xd5,
+	0x06, 0x2e, 0x37, 0x37, 0x71, 0x79, 0xf3, 0xf2, 0xad, 0xab, 0x97, 0x3f, 0x80, 0x8e, 0xa0, 0x44,
+	0xf2, 0xd4, 0xe8, 0xd9, 0xac, 0x5c, 0x1f, 0xb6, 0x10, 0x25, 0xa8, 0xc8, 0x88, 0xc8, 0xd7, 0x46,
+	0xd7, 0x35, 0x9a, 0x42, 0xa4, 0x87, 0x24, 0x26, 0xe9, 0x8c, 0x1a, 0x57, 0xb2, 0x4b, 0xd4, 0x66,
+	0xd5, 0x85, 0xcc, 0xea, 0xcb, 0x56, 0xaf, 0x31, 0x6a, 0x06, 0xed, 0x4c, 0xb0, 0x19, 0xf5, 0xff,
+	0xed, 0x40, 0x6f, 0x32, 0x9b, 0x51, 0x29, 0xb9, 0x50, 0x39, 0x9b, 0x98, 0xef, 0x32, 0x08, 0xc1,
+	0x92, 0xa6, 0x91, 0x72, 0xce, 0x42, 0x40, 0x95, 0xb5, 0x26, 0xab, 0x6d, 0x59, 0xa2, 0xaa, 0x5d,
+	0x55, 0xd4, 0x15, 0x42, 0x95, 0xd6, 0x40, 0x2b, 0x66, 0xd7, 0xb2, 0xca, 0xe6, 0xa0, 0x4c, 0xd7,
+	0xad, 0x5a, 0x75, 0x56, 0x20, 0x6a, 0xbb, 0x8a, 0xa8, 0x13, 0x78, 0xed, 0x29, 0xbf, 0x5e, 0xa9,
+	0x72, 0xb5, 0x92, 0x5e, 0xb9, 0x72, 0x4e, 0x59, 0xe7, 0xbe, 0x0b, 0x70, 0x4f, 0x3e, 0x3e, 0xa1,
+	0x12, 0x9d, 0xe0, 0xd5, 0x6a, 0xe2, 0x1d, 0xdc, 0x69, 0x8f, 0x55, 0x4a, 0xb6, 0xf9, 0xf7, 0x77,
+	0x0e, 0xb4, 0xd4, 0xfa, 0x29, 0x41, 0x56, 0x29, 0x7c, 0x4d, 0x6e, 0x4f, 0x8b, 0x9c, 0xff, 0xd4,
+	0x6a, 0x73, 0x1f, 0xda, 0xd8, 0x89, 0x99, 0x67, 0xea, 0x85, 0x52, 0xa9, 0xc9, 0xb1, 0xa6, 0xe6,
+	0x68, 0x97, 0x35, 0x07, 0xb7, 0x35, 0xc7, 0x47, 0x30, 0x30, 0xc5, 0x0d, 0x5e, 0xf9, 0xcd, 0x2b,
+	0xb5, 0x5d, 0xcf, 0xd6, 0x76, 0x95, 0xaa, 0xee, 0xef, 0x0e, 0x74, 0x0d, 0xf5, 0x79, 0xe8, 0x5a,
+	0xa9, 0x04, 0x1a, 0xb5, 0x4a, 0xe0, 0xda, 0xda, 0xe1, 0x3a, 0xa3, 0x29, 0x40, 0x59, 0xc9, 0x8c,
+	0xa6, 0x11, 0x8d, 0x4c, 0xa1, 0x56, 0x12, 0xdc, 0x8f, 0xc1, 0x2b, 0x1b, 0xa6, 0xa2, 0x82, 0xaf,
+	0x42, 0xe6, 0x41, 0xc1, 0xaf, 0x35, 0x0f, 0xfe, 0x87, 0x30, 0x2c, 0x2a, 0x54, 0x6b, 0xb7, 0x96,
+	0x52, 0x78, 0x11, 0xb9, 0x93, 0x07, 0x68, 0x38, 0x24, 0xfa, 0xff, 0x74, 0xa0, 0xa3, 0x09, 0xf5,
+	0x06, 0xa5, 0x6a, 0xa7, 0xff, 0xfd, 0xd1, 0x75, 0x2d, 0xb6, 0x36, 0xb5, 0xf8, 0xac, 0xd7, 0xb5,
+	0x9f, 0xf5, 0xba, 0x8a, 0x36, 0x3b, 0x9b, 0x2e, 0x83, 0x61, 0x6a, 0x42, 0xdb, 0xc4, 0xec, 0x2d,
+	0xe8, 0x04, 0xcf, 0x69, 0xbe, 0x6e, 0xa9, 0xe7, 0x3f, 0x5b, 0xc4, 0x87, 0xee, 0x24, 0x8e, 0x9f,
+	0x2d, 0x73, 0x1b, 0x76, 0x2c, 0x38, 0x4c, 0x53, 0xdd, 0xd6, 0xdc, 0x84, 0xbe, 0x0d, 0x2d, 0x5b,
+	0xab, 0x96, 0x04, 0xff, 0x4f, 0x0e, 0xb4, 0xcf, 0xf9, 0x23, 0x9a, 0x56, 0xd0, 0x47, 0xc7, 0x8c,
+	0x59, 0x29, 0x38, 0x4c, 0x58, 0xca, 0x85, 0x4d, 0x6f, 0x06, 0xcb, 0x91, 0x36, 0x29, 0x44, 0x66,
+	0x82, 0x46, 0x4c, 0xd5, 0x8b, 0x09, 0xcb, 0x4d, 0x4e, 0x1f, 0x68, 0xda, 0x5d, 0x45, 0x52, 0x65,
+	0x52, 0xcc, 0x2f, 0x43, 0x03, 0x75, 0x61, 0xbe, 0x14, 0x54, 0x2e, 0x79, 0x1c, 0x19, 0x74, 0xdd,
+	0x8b, 0xf9, 0xe5, 0xa7, 0x9a, 0x77, 0x6e, 0x59, 0xfe, 0x31, 0x00, 0x5e, 0xed, 0x4c, 0x29, 0xb1,
+	0x54, 0xad, 0xbe, 0x9e, 0x5e, 0x28, 0x04, 0xd4, 0xb7, 0xd3, 0x3c, 0x7d, 0x39, 0x40, 0x12, 0x6e,
+	0x53, 0xad, 0xd2, 0x01, 0x7e, 0x9d, 0xae, 0xd2, 0x99, 0x6a, 0x50, 0xa2, 0x55, 0x4c, 0x3f, 0x4b,
+	0x73, 0xb1, 0x76, 0x7f, 0x0a, 0x07, 0x74, 0x3e, 0xa7, 0xba, 0xd7, 0xab, 0x41, 0xbe, 0x6e, 0x23,
+	0xf6, 0x0b, 0x6e, 0xb5, 0x78, 0xb3, 0xd3, 0x8f, 0x46, 0x7d, 0xfa, 0x61, 0xed, 0xd1, 0xac, 0xb9,
+	0x6c, 0x71, 0xe9, 0x56, 0xc5, 0x1f, 0x74, 0xb9, 0x9e, 0xf0, 0x0b, 0x1a, 0xf2, 0x0b, 0x2a, 0x04,
+	0x8b, 0x6c, 0x8b, 0x34, 0xd4, 0xe4, 0xfb, 0x86, 0xea, 0x9f, 0xc2, 0xee, 0x95, 0xbb, 0xbb, 0x3f,
+	0x81, 0x2e, 0x4d, 0x73, 0xc1, 0x0a, 0x2c, 0xb9, 0x31, 0x7e, 0xfa, 0x03, 0x03, 0x2b, 0xe7, 0xbf,
+	0x0f, 0xdb, 0xa8, 0xc9, 0x13, 0x3a, 0x63, 0x09, 0x89, 0x71, 0x0e, 0x15, 0x99, 0x6f, 0x7c, 0xec,
+	0x76, 0x50, 0xac, 0xfd, 0x5f, 0x42, 0x5f, 0x95, 0x89, 0x3c, 0x66, 0xb3, 0x75, 0x51, 0x0f, 0xeb,
+	0x48, 0xc4, 0x6f, 0xa5, 0xf3, 0x39, 0x61, 0xf1, 0x4a, 0x50, 0x55, 0x6a, 0x5a, 0x9d, 0x1b, 0xd2,
+	0x29, 0xa5, 0xfe, 0x1f, 0x1c, 0x18, 0x6e, 0x34, 0xd7, 0x1f, 0x01, 0xe8, 0x6e, 0x3a, 0x2f, 0xef,
+	0xbd, 0x37, 0xb6, 0x9d, 0x1c, 0x76, 0xc8, 0x28, 0x18, 0x54, 0xc4, 0x5c, 0x1f, 0x5a, 0x2c, 0xca,
+	0xa4, 0xd7, 0x30, 0xed, 0xf0, 0x34, 0x3a, 0xab, 0x48, 0x22, 0x0f, 0x1d, 0x80, 0x8a, 0x05, 0x8d,
+	0x42, 0x96, 0xe6, 0xdc, 0xb6, 0xad, 0x9a, 0x34, 0x4d, 0x73, 0xee, 0xff, 0xd1, 0x81, 0xed, 0xda,
+	0xc6, 0xeb, 0x01, 0xc6, 0x3e, 0x56, 0x9d, 0x67, 0x8b, 0xff, 0x77, 0xaa, 0xe1, 0xd3, 0x34, 0x1d,
+	0x8a, 0x8d, 0xb1, 0x4a, 0x24, 0xd9, 0x84, 0xd3, 0x2a, 0x13, 0xce, 0x75, 0x0d, 0xf0, 0x6f, 0xc1,
+	0xbd, 0xfa, 0xf0, 0xe7, 0xcc, 0x4c, 0xde, 0x81, 0x9d, 0xca, 0x34, 0x02, 0xab, 0x62, 0xed, 0x80,
+	0xc3, 0x92, 0x8c, 0x25, 0xf1, 0x2b, 0xd0, 0x63, 0x69, 0x0d, 0xe9, 0x8b, 0xf5, 0x97, 0xad, 0x5e,
+	0x73, 0xd4, 0xf2, 0x7f, 0xdf, 0x80, 0x1b, 0x67, 0x34, 0x8d, 0x58, 0xba, 0xb8, 0xd2, 0x51, 0x5f,
+	0xab, 0x9a, 0x8d, 0x4a, 0xa3, 0x71, 0xa5, 0xd2, 0xa8, 0x1b, 0xb8, 0xf9, 0x62, 0x06, 0x7e, 0x91,
+	0x5c, 0xaa, 0x3b, 0xcb, 0xda, 0xd0, 0x25, 0x9c, 0xf1, 0x48, 0x23, 0x2f, 0x76, 0x96, 0x55, 0x47,
+	0x3b, 0x56, 0x36, 0x3b, 0x84, 0xa6, 0xea, 0xaa, 0xbb, 0x87, 0xce, 0x53, 0xdc, 0x46, 0xb1, 0xbe,
+	0x6c, 0xf5, 0x5a, 0xa3, 0xb6, 0xff, 0x16, 0xec, 0x4c, 0xf4, 0xdc, 0xe6, 0x9e, 0xed, 0xea, 0x4b,
+	0x7f, 0x2f, 0x5c, 0xc0, 0xff, 0x0c, 0xde, 0xb3, 0x62, 0x98, 0x6f, 0x4e, 0xb9, 0xd8, 0x54, 0xdc,
+	0x24, 0xc7, 0xc1, 0x6c, 0xa5, 0x7b, 0x2f, 0x8b, 0x0f, 0x93, 0xa5, 0x54, 0x7a, 0xdf, 0x37, 0xb3,
+	0x91, 0x33, 0xb1, 0x4a, 0x59, 0xba, 0x30, 0x31, 0xf6, 0x01, 0xb8, 0x8f, 0x28, 0xcd, 0xc2, 0x98,
+	0x94, 0x53, 0x5f, 0x69, 0x30, 0x68, 0xa4, 0x38, 0x77, 0x49, 0x31, 0xf3, 0x95, 0x85, 0xb4, 0x6a,
+	0x55, 0x52, 0xa3, 0x37, 0xe9, 0x35, 0x4a, 0xe9, 0x00, 0x19, 0xa8, 0x3b, 0xe9, 0x7e, 0x0b, 0xef,
+	0xa2, 0x34, 0x4f, 0xe3, 0x75, 0x38, 0x67, 0x29, 0x89, 0xed, 0x09, 0x21, 0x9f, 0x87, 0xba, 0x83,
+	0xb6, 0xdd, 0xbe, 0x29, 0x7f, 0xde, 0x50, 0x1b, 0xee, 0xa7, 0xf1, 0xfa, 0x54, 0x89, 0x9b, 0x73,
+	0xef, 0xcf, 0x8f, 0x51, 0xd6, 0x74, 0x7f, 0xfe, 0x31, 0x1c, 0xdc, 0x63, 0x29, 0x4b, 0x56, 0x49,
+	0xd1, 0x20, 0xe0, 0xf4, 0x87, 0xba, 0xef, 0xc2, 0xa8, 0xe8, 0x24, 0xf4, 0xac, 0x48, 0xbb, 0x73,
+	0x3b, 0xd8, 0x91, 0x75, 0x51, 0xff, 0x12, 0xb6, 0x3f, 0x57, 0x08, 0x98, 0x2a, 0xdc, 0x57, 0x95,
+	0xe5, 0x4b, 0xd0, 0x51, 0xb5, 0x61, 0xe1, 0x7d, 0xed, 0x47, 0x74, 0x3d, 0x8d, 0x36, 0x06, 0xdb,
+	0x8d, 0xcd, 0xc1, 0xf6, 0x75, 0x73, 0xd7, 0xe6, 0x75, 0x73, 0x57, 0x55, 0x25, 0x42, 0x79, 0xb2,
+	0xc2, 0x99, 0x47, 0x74, 0x5d, 0x8e, 0xdd, 0x6a, 0x97, 0x0a, 0x90, 0xa7, 0xc2, 0xb3, 0x4c, 0x5a,
+	0x0d, 0x7c, 0x4f, 0x49, 0x50, 0xa9, 0x24, 0x13, 0x3c, 0xe3, 0x92, 0xc4, 0x61, 0xdd, 0xa3, 0x75,
+	0x2e, 0xdc, 0xb7, 0xdc, 0xf3, 0x6a, 0x95, 0xf8, 0x97, 0x06, 0x6c, 0x7d, 0x7d, 0x32, 0x3d, 0x39,
+	0x33, 0x4c, 0x15, 0x65, 0xc5, 0xcf, 0x94, 0xf5, 0xbc, 0x25, 0xe9, 0x52, 0xd5, 0xb4, 0x34, 0x8d,
+	0x5a, 0x4b, 0x73, 0x00, 0x1d, 0xdd, 0x30, 0xdb, 0xfc, 0xa3, 0x57, 0x98, 0xfc, 0x71, 0xd6, 0xa6,
+	0x80, 0xbe, 0x65, 0x92, 0xbf, 0x25, 0x5c, 0x3f, 0xbb, 0x68, 0x5f, 0x3f, 0xbb, 0x78, 0x0b, 0x86,
+	0x11, 0x25, 0x51, 0xcc, 0x52, 0x93, 0x33, 0x31, 0x0e, 0x9b, 0xc1, 0xb6, 0xa5, 0xea, 0xa0, 0x2d,
+	0xfb, 0xd7, 0x6e, 0xad, 0x7f, 0x7d, 0x1d, 0x06, 0x82, 0xca, 0x55, 0x9c, 0xeb, 0x18, 0xee, 0x61,
+	0xee, 0x01, 0x4d, 0xc2, 0xd8, 0x7d, 0x0d, 0xcc, 0x2a, 0x8c, 0xf9, 0xc2, 0x8c, 0xf9, 0xfb, 0x9a,
+	0x72, 0x97, 0x2f, 0xfc, 0xef, 0x1d, 0xe8, 0xaa, 0x2a, 0x51, 0xd9, 0xfd, 0x39, 0xff, 0xef, 0xb8,
+	0xce, 0x2d, 0x1a, 0xd7, 0x8e, 0xe3, 0x8f, 0x60, 0xa4, 0x5b, 0x61, 0x9c, 0x0b, 0x54, 0xed, 0xa7,
+	0x7b, 0x61, 0x35, 0x11, 0xd0, 0xcf, 0x7b, 0x13, 0x34, 0x25, 0xcc, 0xb9, 0x91, 0xd3, 0x09, 0x7e,
+	0x0b, 0xa9, 0xe7, 0x5c, 0xdb, 0x77, 0x0c, 0x43, 0x73, 0x57, 0xdb, 0x30, 0xdf, 0xac, 0x79, 0x5a,
+	0x6f, 0x6c, 0xd8, 0xda, 0xc7, 0xfc, 0xbf, 0x3a, 0xb0, 0xa3, 0xff, 0x9f, 0x13, 0xd3, 0x05, 0xc9,
+	0xff, 0x9f, 0x21, 0xa1, 0xda, 0x52, 0xed, 0x4b, 0xd6, 0x4f, 0xec, 0x52, 0x55, 0x77, 0xf4, 0x49,
+	0xc6, 0xc4, 0xba, 0x86, 0xd1, 0x03, 0x4d, 0xd3, 0x0f, 0xfd, 0x04, 0xf6, 0x36, 0xee, 0x6d, 0xda,
+	0x9e, 0xea, 0x6b, 0x47, 0xe3, 0x0d, 0x19, 0xf3, 0xea, 0x33, 0xd8, 0xbd, 0x2f, 0x16, 0x24, 0x65,
+	0xbf, 0x41, 0x67, 0xd3, 0xd9, 0xf0, 0x65, 0xe8, 0x69, 0xa8, 0x2f, 0x1e, 0xde, 0xc5, 0xf5, 0x34,
+	0x72, 0x0f, 0x61, 0xcb, 0xe4, 0xa8, 0xea, 0x6c, 0x09, 0x74, 0xa2, 0xc2, 0xde, 0xe3, 0xcf, 0x0e,
+	0xec, 0x61, 0xbd, 0x73, 0x2e, 0x48, 0x2a, 0xe7, 0x54, 0x18, 0xa0, 0xf5, 0x54, 0xe5, 0x44, 0x1e,
+	0xc6, 0x34, 0x32, 0x83, 0x66, 0xbb, 0x54, 0x96, 0xc7, 0x09, 0x7e, 0x28, 0x49, 0x42, 0x43, 0x1c,
+	0x08, 0xa3, 0x52, 0x7b, 0xc1, 0x10, 0xe9, 0x0f, 0x48, 0x42, 0xf5, 0x10, 0xf9, 0x18, 0xf6, 0x78,
+	0xe5, 0xb6, 0x3a, 0x21, 0xd9, 0x84, 0xe7, 0x8e, 0xaf, 0xbc, 0x24, 0x70, 0xf9, 0x26, 0x49, 0x3e,
+	0xec, 0xe0, 0x3f, 0x07, 0x3f, 0xfa, 0xef, 0x00, 0x0b, 0x39, 0xb8, 0xc3, 0x36, 0x1c, 0x00, 0x00,
 }
@@ -20,12 +20,50 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type SignatureScheme int32
+
+const (
+	// RSA PKCS#1 v1.5, signed data is base64 encoded
+	SignatureScheme_PKCS1V15_SHA256_BASE64 SignatureScheme = 0
+	// RSA PSS, signed data is base64 encoded
+	SignatureScheme_PSS_SHA256_BASE64 SignatureScheme = 1
+	// RSA PSS, signed data is not encoded
+	SignatureScheme_PSS_SHA256 SignatureScheme = 2
+)
+
+var SignatureScheme_name = map[int32]string{
+	0: "PKCS1V15_SHA256_BASE64",
+	1: "PSS_SHA256_BASE64",
+	2: "PSS_SHA256",
+}
+
+var SignatureScheme_value = map[string]int32{
+	"PKCS1V15_SHA256_BASE64": 0,
+	"PSS_SHA256_BASE64":      1,
+	"PSS_SHA256":             2,
+}
+
+func (x SignatureScheme) String() string {
+	return proto.EnumName(SignatureScheme_name, int32(x))
+}
+
+func (SignatureScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a91b4db4311f0d35, []int{0}
+}
+
 type Tx struct {
-	Method               string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
-	Params               string   `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
-	Nonce                []byte   `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Signature            []byte   `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
-	NodeId               string   `protobuf:"bytes,5,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Method          string          `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Params          string          `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	Nonce           []byte          `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Signature       []byte          `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	NodeId          string          `protobuf:"bytes,5,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SignatureScheme SignatureScheme `protobuf:"varint,6,opt,name=signature_scheme,json=signatureScheme,proto3,enum=SignatureScheme" json:"signature_scheme,omitempty"`
+	// serialized ndid.params.v1.TxParams, used instead of params
+	TypedParams []byte `protobuf:"bytes,7,opt,name=typed_params,json=typedParams,proto3" json:"typed_params,omitempty"`
+	// last block height which Tx can be included in, 0 is no expiry
+	ValidUntilBlock int64 `protobuf:"varint,8,opt,name=valid_until_block,json=validUntilBlock,proto3" json:"valid_until_block,omitempty"`
+	// ID of delegate key of node which Tx is signed with, empty for node key
+	KeyId                string   `protobuf:"bytes,9,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -91,9 +129,39 @@ func (m *Tx) GetNodeId() string {
 	return ""
 }
 
+func (m *Tx) GetSignatureScheme() SignatureScheme {
+	if m != nil {
+		return m.SignatureScheme
+	}
+	return SignatureScheme_PKCS1V15_SHA256_BASE64
+}
+
+func (m *Tx) GetTypedParams() []byte {
+	if m != nil {
+		return m.TypedParams
+	}
+	return nil
+}
+
+func (m *Tx) GetValidUntilBlock() int64 {
+	if m != nil {
+		return m.ValidUntilBlock
+	}
+	return 0
+}
+
+func (m *Tx) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
 type Query struct {
-	Method               string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
-	Params               string   `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Params string `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// serialized ndid.params.v1.QueryParams, used instead of params
+	TypedParams          []byte   `protobuf:"bytes,3,opt,name=typed_params,json=typedParams,proto3" json:"typed_params,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -138,23 +206,43 @@ func (m *Query) GetParams() string {
 	return ""
 }
 
+func (m *Query) GetTypedParams() []byte {
+	if m != nil {
+		return m.TypedParams
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("SignatureScheme", SignatureScheme_name, SignatureScheme_value)
 	proto.RegisterType((*Tx)(nil), "Tx")
 	proto.RegisterType((*Query)(nil), "Query")
 }
 
-func init() { proto.RegisterFile("protos/tendermint/tendermint.proto", fileDescriptor_a91b4db4311f0d35) }
+func init() {
+	proto.RegisterFile("protos/tendermint/tendermint.proto", fileDescriptor_a91b4db4311f0d35)
+}
 
 var fileDescriptor_a91b4db4311f0d35 = []byte{
-	// 159 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x28, 0xca, 0x2f,
-	0xc9, 0x2f, 0xd6, 0x2f, 0x49, 0xcd, 0x4b, 0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0x41, 0x62, 0xea,
-	0x81, 0x25, 0x95, 0x1a, 0x19, 0xb9, 0x98, 0x42, 0x2a, 0x84, 0xc4, 0xb8, 0xd8, 0x72, 0x53, 0x4b,
-	0x32, 0xf2, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xa0, 0x3c, 0x90, 0x78, 0x41, 0x62,
-	0x51, 0x62, 0x6e, 0xb1, 0x04, 0x13, 0x44, 0x1c, 0xc2, 0x13, 0x12, 0xe1, 0x62, 0xcd, 0xcb, 0xcf,
-	0x4b, 0x4e, 0x95, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x09, 0x82, 0x70, 0x84, 0x64, 0xb8, 0x38, 0x8b,
-	0x33, 0xd3, 0xf3, 0x12, 0x4b, 0x4a, 0x8b, 0x52, 0x25, 0x58, 0xc0, 0x32, 0x08, 0x01, 0x21, 0x71,
-	0x2e, 0xf6, 0xbc, 0xfc, 0x94, 0xd4, 0xf8, 0xcc, 0x14, 0x09, 0x56, 0x88, 0x61, 0x20, 0xae, 0x67,
-	0x8a, 0x92, 0x39, 0x17, 0x6b, 0x60, 0x69, 0x6a, 0x51, 0x25, 0xa9, 0xae, 0x48, 0x62, 0x03, 0xfb,
-	0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x48, 0x6c, 0xd7, 0xd7, 0xe9, 0x00, 0x00, 0x00,
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x41, 0x4f, 0xc2, 0x30,
+	0x18, 0x86, 0xdd, 0x70, 0x43, 0x3e, 0x09, 0x8c, 0x46, 0xb0, 0x31, 0x1e, 0x26, 0xa7, 0x85, 0x03,
+	0x06, 0x14, 0x2e, 0x9e, 0xc0, 0x98, 0x48, 0xbc, 0xe0, 0x8a, 0x1e, 0xbc, 0x34, 0x83, 0x36, 0xb2,
+	0xc0, 0x5a, 0xb2, 0x15, 0xe3, 0xfe, 0x8f, 0x3f, 0xd4, 0xac, 0x43, 0xd1, 0x79, 0xf2, 0xd6, 0xf7,
+	0x79, 0x9b, 0xf6, 0x69, 0x3f, 0x68, 0x6f, 0x62, 0xa9, 0x64, 0x72, 0xa9, 0xb8, 0x60, 0x3c, 0x8e,
+	0x42, 0xa1, 0x7e, 0x2c, 0xbb, 0xba, 0x6c, 0x7f, 0x98, 0x60, 0xce, 0xde, 0x51, 0x0b, 0xec, 0x88,
+	0xab, 0xa5, 0x64, 0xd8, 0x70, 0x0d, 0xaf, 0xe2, 0xef, 0x52, 0xc6, 0x37, 0x41, 0x1c, 0x44, 0x09,
+	0x36, 0x73, 0x9e, 0x27, 0x74, 0x02, 0x96, 0x90, 0x62, 0xc1, 0x71, 0xc9, 0x35, 0xbc, 0xaa, 0x9f,
+	0x07, 0x74, 0x0e, 0x95, 0x24, 0x7c, 0x15, 0x81, 0xda, 0xc6, 0x1c, 0x1f, 0xea, 0x66, 0x0f, 0xd0,
+	0x29, 0x94, 0x85, 0x64, 0x9c, 0x86, 0x0c, 0x5b, 0xf9, 0x61, 0x59, 0x9c, 0x30, 0x74, 0x03, 0xce,
+	0xf7, 0x2e, 0x9a, 0x2c, 0x96, 0x3c, 0xe2, 0xd8, 0x76, 0x0d, 0xaf, 0xd6, 0x77, 0xba, 0xe4, 0xab,
+	0x20, 0x9a, 0xfb, 0xf5, 0xe4, 0x37, 0x40, 0x17, 0x50, 0x55, 0xe9, 0x86, 0x33, 0xba, 0xf3, 0x2c,
+	0xeb, 0x6b, 0x8f, 0x35, 0x9b, 0xe6, 0xb2, 0x1d, 0x68, 0xbc, 0x05, 0xeb, 0x90, 0xd1, 0xad, 0x50,
+	0xe1, 0x9a, 0xce, 0xd7, 0x72, 0xb1, 0xc2, 0x47, 0xae, 0xe1, 0x95, 0xfc, 0xba, 0x2e, 0x9e, 0x32,
+	0x3e, 0xce, 0x30, 0x6a, 0x82, 0xbd, 0xe2, 0x69, 0xe6, 0x58, 0xd1, 0x8e, 0xd6, 0x8a, 0xa7, 0x13,
+	0xd6, 0x7e, 0x01, 0xeb, 0x71, 0xcb, 0xe3, 0xf4, 0xdf, 0x1f, 0x55, 0xd4, 0x2b, 0xfd, 0xd1, 0xeb,
+	0xcc, 0xa0, 0x5e, 0x78, 0x25, 0x3a, 0x83, 0xd6, 0xf4, 0xe1, 0x96, 0xf4, 0x9e, 0x7b, 0x03, 0x4a,
+	0xee, 0x47, 0xfd, 0xc1, 0x90, 0x8e, 0x47, 0xe4, 0x6e, 0x78, 0xed, 0x1c, 0xa0, 0x26, 0x34, 0xa6,
+	0x84, 0x14, 0xb0, 0x81, 0x6a, 0x00, 0x7b, 0xec, 0x98, 0x73, 0x5b, 0xcf, 0xf7, 0xea, 0x73, 0x00,
+	0x7b, 0x3d, 0x02, 0x13, 0x05, 0x02, 0x00, 0x00,
 }
@@ -3,6 +3,7 @@
 
 import sys
 _b=sys.version_info[0]<3 and (lambda x:x) or (lambda x:x.encode('latin1'))
+from google.protobuf.internal import enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from google.protobuf import reflection as _reflection
@@ -19,9 +20,39 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='',
   syntax='proto3',
   serialized_options=None,
-  serialized_pb=_b('\n\"protos/tendermint/tendermint.proto\"W\n\x02Tx\x12\x0e\n\x06method\x18\x01 \x01(\t\x12\x0e\n\x06params\x18\x02 \x01(\t\x12\r\n\x05nonce\x18\x03 \x01(\x0c\x12\x11\n\tsignature\x18\x04 \x01(\x0c\x12\x0f\n\x07node_id\x18\x05 \x01(\t\"\'\n\x05Query\x12\x0e\n\x06method\x18\x01 \x01(\t\x12\x0e\n\x06params\x18\x02 \x01(\tb\x06proto3')
+  serialized_pb=_b('\n\"protos/tendermint/tendermint.proto\"\xc4\x01\n\x02Tx\x12\x0e\n\x06method\x18\x01 \x01(\t\x12\x0e\n\x06params\x18\x02 \x01(\t\x12\r\n\x05nonce\x18\x03 \x01(\x0c\x12\x11\n\tsignature\x18\x04 \x01(\x0c\x12\x0f\n\x07node_id\x18\x05 \x01(\t\x12*\n\x10signature_scheme\x18\x06 \x01(\x0e2\x10.SignatureScheme\x12\x14\n\x0ctyped_params\x18\x07 \x01(\x0c\x12\x19\n\x11valid_until_block\x18\x08 \x01(\x03\x12\x0e\n\x06key_id\x18\t \x01(\t\"=\n\x05Query\x12\x0e\n\x06method\x18\x01 \x01(\t\x12\x0e\n\x06params\x18\x02 \x01(\t\x12\x14\n\x0ctyped_params\x18\x03 \x01(\x0c*T\n\x0fSignatureScheme\x12\x1a\n\x16PKCS1V15_SHA256_BASE64\x10\x00\x12\x15\n\x11PSS_SHA256_BASE64\x10\x01\x12\x0e\n\nPSS_SHA256\x10\x02b\x06proto3')
 )
 
+_SIGNATURESCHEME = _descriptor.EnumDescriptor(
+  name='SignatureScheme',
+  full_name='SignatureScheme',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='PKCS1V15_SHA256_BASE64', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='PSS_SHA256_BASE64', index=1, number=1,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='PSS_SHA256', index=2, number=2,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=300,
+  serialized_end=384,
+)
+_sym_db.RegisterEnumDescriptor(_SIGNATURESCHEME)
+
+SignatureScheme = enum_type_wrapper.EnumTypeWrapper(_SIGNATURESCHEME)
+PKCS1V15_SHA256_BASE64 = 0
+PSS_SHA256_BASE64 = 1
+PSS_SHA256 = 2
 
 
 
@@ -67,6 +98,34 @@ _TX = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='signature_scheme', full_name='Tx.signature_scheme', index=5,
+      number=6, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='typed_params', full_name='Tx.typed_params', index=6,
+      number=7, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='valid_until_block', full_name='Tx.valid_until_block', index=7,
+      number=8, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='key_id', full_name='Tx.key_id', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -79,8 +138,8 @@ _TX = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=38,
-  serialized_end=125,
+  serialized_start=39,
+  serialized_end=235,
 )
 
 
@@ -105,6 +164,13 @@ _QUERY = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='typed_params', full_name='Query.typed_params', index=2,
+      number=3, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -117,12 +183,14 @@ _QUERY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=127,
-  serialized_end=166,
+  serialized_start=237,
+  serialized_end=298,
 )
 
+_TX.fields_by_name['signature_scheme'].enum_type = _SIGNATURESCHEME
 DESCRIPTOR.message_types_by_name['Tx'] = _TX
 DESCRIPTOR.message_types_by_name['Query'] = _QUERY
+DESCRIPTOR.enum_types_by_name['SignatureScheme'] = _SIGNATURESCHEME
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 Tx = _reflection.GeneratedProtocolMessageType('Tx', (_message.Message,), dict(
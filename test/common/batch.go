@@ -0,0 +1,112 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestBatchRollback(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	expectNamespaces := func(expected ...string) {
+		t.Helper()
+		var namespaces []app.Namespace
+		testApp.QueryResult("GetNamespaceList", struct{}{}, &namespaces)
+		if len(namespaces) != len(expected) {
+			t.Fatalf("FAIL: namespaces\nExpected: %v\nActual: %v", expected, namespaces)
+		}
+		for index, namespace := range namespaces {
+			if namespace.Namespace != expected[index] {
+				t.Fatalf("FAIL: namespaces\nExpected: %v\nActual: %v", expected, namespaces)
+			}
+		}
+	}
+
+	// Second operation fails only in DeliverTx (it depends on the first one)
+	// so changes of the first operation are rolled back
+	testApp.ExpectDeliver("Batch", app.BatchParam{
+		Operations: []app.BatchOperation{
+			local.NewBatchOperation("AddNamespace", app.Namespace{Namespace: "citizenId", Description: "Citizen ID"}),
+			local.NewBatchOperation("AddNamespace", app.Namespace{Namespace: "citizenId", Description: "Citizen ID"}),
+		},
+	}, local.NDID, local.NDIDPrivKey, code.DuplicateNamespace)
+	expectNamespaces()
+
+	testApp.MustDeliver("Batch", app.BatchParam{
+		Operations: []app.BatchOperation{
+			local.NewBatchOperation("AddNamespace", app.Namespace{Namespace: "citizenId", Description: "Citizen ID"}),
+			local.NewBatchOperation("AddNamespace", app.Namespace{Namespace: "passport", Description: "Passport"}),
+		},
+	}, local.NDID, local.NDIDPrivKey)
+	expectNamespaces("citizenId", "passport")
+
+	testApp.ExpectDeliver("Batch", app.BatchParam{
+		Operations: []app.BatchOperation{
+			local.NewBatchOperation("InitNDID", app.InitNDIDParam{NodeID: local.NDID}),
+		},
+	}, local.NDID, local.NDIDPrivKey, code.MethodCannotBeInBatch)
+	t.Logf("PASS: Batch rollback")
+}
+
+func TestBatchFeeOnFailure(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	failingBatch := app.BatchParam{
+		Operations: []app.BatchOperation{
+			local.NewBatchOperation("SetMqAddresses", app.SetMqAddressesParam{
+				Addresses: []app.MsqAddress{{IP: "127.0.0.1", Port: 8000}},
+			}),
+			// Denied in DeliverTx until NDID sets token transfer policy
+			local.NewBatchOperation("TransferToken", app.TransferTokenParam{ToNodeID: local.IdP2, Amount: "1"}),
+		},
+	}
+
+	// Every operation is charged as a failed Tx after batch is rolled back
+	testApp.ExpectDeliver("Batch", failingBatch, local.IdP1, local.IdP1PrivKey, code.TokenTransferIsNotAllowed)
+	testApp.ExpectToken(local.IdP1, "98")
+	testApp.ExpectToken(local.IdP2, "100")
+
+	testApp.MustDeliver("SetFeePolicy", app.SetFeePolicyParam{
+		Method: "SetMqAddresses",
+		Mode:   "success_only",
+	}, local.NDID, local.NDIDPrivKey)
+	testApp.ExpectDeliver("Batch", failingBatch, local.IdP1, local.IdP1PrivKey, code.TokenTransferIsNotAllowed)
+	testApp.ExpectToken(local.IdP1, "97")
+
+	// Token ledger has only fees charged after rollback
+	var statement app.GetTokenStatementResult
+	testApp.QueryResult("GetTokenStatement", app.GetTokenStatementParam{NodeID: local.IdP1}, &statement)
+	expectedMethods := []string{"SetNodeToken", "SetMqAddresses", "TransferToken", "TransferToken"}
+	if len(statement.Entries) != len(expectedMethods) {
+		t.Fatalf("FAIL: GetTokenStatement\nActual: %+v", statement.Entries)
+	}
+	for index, entry := range statement.Entries {
+		if entry.Method != expectedMethods[index] {
+			t.Fatalf("FAIL: GetTokenStatement\nActual: %+v", statement.Entries)
+		}
+	}
+	t.Logf("PASS: Batch fee on failure")
+}
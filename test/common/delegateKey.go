@@ -0,0 +1,90 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/test/data"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+	"github.com/ndidplatform/smart-contract/v4/test/utils"
+)
+
+func TestDelegateKeyScope(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	delegatePrivKey := utils.GetPrivateKeyFromString(data.AsPrivK2)
+	setMqAddressesParam := app.SetMqAddressesParam{
+		Addresses: []app.MsqAddress{{IP: "127.0.0.1", Port: 8000}},
+	}
+	expectDelegateTx := func(method string, param interface{}, keyID string, expectedCode uint32) {
+		t.Helper()
+		resultCode, resultLog := testApp.CheckAndDeliverTx(local.NewDelegateTx(method, param, local.IdP1, keyID, delegatePrivKey, 0))
+		if resultCode != expectedCode {
+			t.Fatalf("FAIL: %s signed with delegate key %q\nExpected code: %d\nActual: %d (%s)", method, keyID, expectedCode, resultCode, resultLog)
+		}
+	}
+
+	testApp.ExpectDeliver("AddNodeDelegateKey", app.AddNodeDelegateKeyParam{
+		KeyID:     "mq",
+		PublicKey: local.PublicKeyPEM(delegatePrivKey),
+		Methods:   []string{"RotateNodeKey"},
+	}, local.IdP1, local.IdP1PrivKey, code.InvalidDelegateKeyMethod)
+	expiryBlock := testApp.Height + 10
+	testApp.MustDeliver("AddNodeDelegateKey", app.AddNodeDelegateKeyParam{
+		KeyID:       "mq",
+		PublicKey:   local.PublicKeyPEM(delegatePrivKey),
+		Methods:     []string{"SetMqAddresses"},
+		ExpiryBlock: expiryBlock,
+	}, local.IdP1, local.IdP1PrivKey)
+
+	// Delegate key can only sign methods in its scope
+	expectDelegateTx("SetMqAddresses", setMqAddressesParam, "mq", code.OK)
+	expectDelegateTx("TransferToken", map[string]interface{}{
+		"to_node_id": local.IdP2,
+		"amount":     1,
+	}, "mq", code.MethodIsNotInDelegateKeyScope)
+	expectDelegateTx("Batch", app.BatchParam{
+		Operations: []app.BatchOperation{local.NewBatchOperation("SetMqAddresses", setMqAddressesParam)},
+	}, "mq", code.MethodIsNotInDelegateKeyScope)
+	expectDelegateTx("SetMqAddresses", setMqAddressesParam, "unknown", code.DelegateKeyNotFound)
+	// Delegate key is not a node key
+	expectDelegateTx("SetMqAddresses", setMqAddressesParam, "", code.VerifySignatureError)
+
+	// Delegate key can sign until expiry block
+	testApp.EmptyBlocks(int(expiryBlock - testApp.Height - 1))
+	expectDelegateTx("SetMqAddresses", setMqAddressesParam, "mq", code.OK)
+	expectDelegateTx("SetMqAddresses", setMqAddressesParam, "mq", code.DelegateKeyIsExpired)
+
+	// Removed delegate key cannot sign
+	testApp.MustDeliver("AddNodeDelegateKey", app.AddNodeDelegateKeyParam{
+		KeyID:     "mq2",
+		PublicKey: local.PublicKeyPEM(delegatePrivKey),
+		Methods:   []string{"SetMqAddresses"},
+	}, local.IdP1, local.IdP1PrivKey)
+	expectDelegateTx("SetMqAddresses", setMqAddressesParam, "mq2", code.OK)
+	testApp.MustDeliver("RemoveNodeDelegateKey", app.RemoveNodeDelegateKeyParam{KeyID: "mq2"}, local.IdP1, local.IdP1PrivKey)
+	expectDelegateTx("SetMqAddresses", setMqAddressesParam, "mq2", code.DelegateKeyNotFound)
+	t.Logf("PASS: delegate key scope")
+}
@@ -0,0 +1,121 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package common
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	protoTm "github.com/ndidplatform/smart-contract/v4/protos/tendermint"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestNodeKeyTypes(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	p256PrivKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secp256k1PrivKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ed25519PrivKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p384PrivKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registerNode := func(nodeID string, privKey interface{}) (uint32, string) {
+		publicKey := local.PKIXPublicKeyPEM(privKey)
+		return testApp.Deliver("RegisterNode", app.RegisterNode{
+			NodeID:          nodeID,
+			PublicKey:       publicKey,
+			MasterPublicKey: publicKey,
+			NodeName:        nodeID,
+			Role:            "IdP",
+			MaxIal:          3,
+			MaxAal:          3,
+		}, local.NDID, local.NDIDPrivKey)
+	}
+	setMqAddressesParam := app.SetMqAddressesParam{
+		Addresses: []app.MsqAddress{{IP: "127.0.0.1", Port: 8000}},
+	}
+	schemes := []protoTm.SignatureScheme{
+		protoTm.SignatureScheme_PKCS1V15_SHA256_BASE64,
+		protoTm.SignatureScheme_PSS_SHA256_BASE64,
+		protoTm.SignatureScheme_PSS_SHA256,
+	}
+
+	nodes := []struct {
+		nodeID    string
+		privKey   interface{}
+		algorithm string
+	}{
+		{local.IdP1, local.IdP1PrivKey, "RSA"},
+		{"idp-p256", p256PrivKey, "ECDSA_SECP256R1"},
+		{"idp-secp256k1", secp256k1PrivKey, "ECDSA_SECP256K1"},
+		{"idp-ed25519", ed25519PrivKey, "ED25519"},
+	}
+	for _, node := range nodes {
+		if node.nodeID != local.IdP1 {
+			if resultCode, resultLog := registerNode(node.nodeID, node.privKey); resultCode != code.OK {
+				t.Fatalf("FAIL: RegisterNode with %s key\nActual: %d (%s)", node.algorithm, resultCode, resultLog)
+			}
+			testApp.MustDeliver("SetNodeToken", map[string]interface{}{
+				"node_id": node.nodeID,
+				"amount":  100,
+			}, local.NDID, local.NDIDPrivKey)
+		}
+		var nodePublicKey app.GetNodePublicKeyResult
+		testApp.QueryResult("GetNodePublicKey", app.GetNodePublicKeyParam{NodeID: node.nodeID}, &nodePublicKey)
+		if nodePublicKey.PublicKeyAlgorithm != node.algorithm {
+			t.Fatalf("FAIL: GetNodePublicKey of %s\nExpected algorithm: %s\nActual: %s", node.nodeID, node.algorithm, nodePublicKey.PublicKeyAlgorithm)
+		}
+		for _, scheme := range schemes {
+			resultCode, resultLog := testApp.CheckAndDeliverTx(local.NewSchemeTx("SetMqAddresses", setMqAddressesParam, node.nodeID, node.privKey, scheme))
+			if resultCode != code.OK {
+				t.Fatalf("FAIL: Tx signed with %s key (%s)\nActual: %d (%s)", node.algorithm, scheme, resultCode, resultLog)
+			}
+		}
+	}
+
+	// Signature by key of other type is rejected
+	resultCode, _ := testApp.CheckAndDeliverTx(local.NewSchemeTx("SetMqAddresses", setMqAddressesParam, "idp-p256", secp256k1PrivKey, protoTm.SignatureScheme_PSS_SHA256))
+	if resultCode != code.VerifySignatureError {
+		t.Fatalf("FAIL: Tx signed with key of other type\nExpected code: %d\nActual: %d", code.VerifySignatureError, resultCode)
+	}
+	// ECDSA on curve other than secp256r1 and secp256k1 is not supported
+	if resultCode, resultLog := registerNode("idp-p384", p384PrivKey); resultCode != code.UnsupportedKeyType {
+		t.Fatalf("FAIL: RegisterNode with P-384 key\nExpected code: %d\nActual: %d (%s)", code.UnsupportedKeyType, resultCode, resultLog)
+	}
+	t.Logf("PASS: Node key types")
+}
@@ -0,0 +1,83 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package common
+
+import (
+	"crypto/rsa"
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/test/data"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+	"github.com/ndidplatform/smart-contract/v4/test/utils"
+)
+
+func TestNodeKeyRotationGraceWindow(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	newIdp1PrivKey := utils.GetPrivateKeyFromString(data.AsPrivK2)
+	setMqAddressesParam := app.SetMqAddressesParam{
+		Addresses: []app.MsqAddress{{IP: "127.0.0.1", Port: 8000}},
+	}
+
+	// Tx signed with master key (same as node key of idp1)
+	testApp.ExpectDeliver("RotateNodeKey", app.RotateNodeKeyParam{
+		PublicKey:             local.PublicKeyPEM(newIdp1PrivKey),
+		ActivationBlockHeight: testApp.Height + 3,
+		GracePeriodBlock:      -1,
+	}, local.IdP1, local.IdP1PrivKey, code.InvalidGracePeriodBlock)
+	activationBlockHeight := testApp.Height + 4
+	gracePeriodBlock := int64(2)
+	testApp.MustDeliver("RotateNodeKey", app.RotateNodeKeyParam{
+		PublicKey:             local.PublicKeyPEM(newIdp1PrivKey),
+		ActivationBlockHeight: activationBlockHeight,
+		GracePeriodBlock:      gracePeriodBlock,
+	}, local.IdP1, local.IdP1PrivKey)
+
+	// expectSignedAt delivers Tx signed with privKey in block at height
+	expectSignedAt := func(height int64, privKey *rsa.PrivateKey, expectedCode uint32) {
+		t.Helper()
+		testApp.EmptyBlocks(int(height - testApp.Height - 1))
+		testApp.ExpectDeliver("SetMqAddresses", setMqAddressesParam, local.IdP1, privKey, expectedCode)
+	}
+
+	// Before activation only previous key is accepted
+	expectSignedAt(activationBlockHeight-2, newIdp1PrivKey, code.VerifySignatureError)
+	expectSignedAt(activationBlockHeight-1, local.IdP1PrivKey, code.OK)
+	// Both keys are accepted from activation until end of grace period
+	expectSignedAt(activationBlockHeight, newIdp1PrivKey, code.OK)
+	expectSignedAt(activationBlockHeight+1, local.IdP1PrivKey, code.OK)
+	expectSignedAt(activationBlockHeight+gracePeriodBlock, local.IdP1PrivKey, code.OK)
+	// Previous key is rejected after grace period
+	expectSignedAt(activationBlockHeight+gracePeriodBlock+1, local.IdP1PrivKey, code.VerifySignatureError)
+	expectSignedAt(activationBlockHeight+gracePeriodBlock+2, newIdp1PrivKey, code.OK)
+
+	var history app.GetNodeKeyHistoryResult
+	testApp.QueryResult("GetNodeKeyHistory", app.GetNodeKeyHistoryParam{NodeID: local.IdP1}, &history)
+	if len(history.Keys) != 2 ||
+		history.Keys[0].ValidToBlock != activationBlockHeight+gracePeriodBlock ||
+		history.Keys[1].ValidFromBlock != activationBlockHeight {
+		t.Fatalf("FAIL: GetNodeKeyHistory\nActual: %+v", history.Keys)
+	}
+	t.Logf("PASS: RotateNodeKey grace window")
+}
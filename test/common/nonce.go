@@ -0,0 +1,164 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package common
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	protoTm "github.com/ndidplatform/smart-contract/v4/protos/tendermint"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestNonceReplayAcrossUpgrade(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	tx := local.NewTx("AddNamespace", app.Namespace{Namespace: "citizenId", Description: "Citizen ID"}, local.NDID, local.NDIDPrivKey, 0)
+	var txObj protoTm.Tx
+	err := proto.Unmarshal(tx, &txObj)
+	if err != nil {
+		t.Fatalf("FAIL: cannot unmarshal Tx: %s", err.Error())
+	}
+
+	// Tx delivered before upgrade only left its nonce as a raw key
+	testApp.DB.Set(txObj.Nonce, []byte{})
+	testApp.DB.Delete([]byte("LegacyNonceMigrated"))
+	testApp = local.NewApp(t, testApp.DB)
+
+	checkTxResult := testApp.App.CheckTx(types.RequestCheckTx{Tx: tx})
+	if checkTxResult.Code != code.DuplicateNonce {
+		t.Fatalf("FAIL: CheckTx of replayed Tx\nExpected code: %d\nActual: %d (%s)", code.DuplicateNonce, checkTxResult.Code, checkTxResult.Log)
+	}
+	deliverTxResult := testApp.DeliverTx(tx)
+	if deliverTxResult.Code != code.DuplicateNonce {
+		t.Fatalf("FAIL: DeliverTx of replayed Tx\nExpected code: %d\nActual: %d (%s)", code.DuplicateNonce, deliverTxResult.Code, deliverTxResult.Log)
+	}
+	// Raw nonce key is moved under nonce key prefix in the first block
+	if testApp.StateValue(txObj.Nonce) != nil || testApp.StateValue(append([]byte("Nonce|"), txObj.Nonce...)) == nil {
+		t.Fatalf("FAIL: raw nonce key is not migrated")
+	}
+
+	// Nonce of Tx delivered after upgrade is also rejected
+	testApp.MustDeliver("AddNamespace", app.Namespace{Namespace: "passport", Description: "Passport"}, local.NDID, local.NDIDPrivKey)
+	tx = local.NewTx("AddNamespace", app.Namespace{Namespace: "email", Description: "Email"}, local.NDID, local.NDIDPrivKey, 0)
+	deliverTxResult = testApp.DeliverTx(tx)
+	if deliverTxResult.Code != code.OK {
+		t.Fatalf("FAIL: DeliverTx\nExpected code: %d\nActual: %d (%s)", code.OK, deliverTxResult.Code, deliverTxResult.Log)
+	}
+	deliverTxResult = testApp.DeliverTx(tx)
+	if deliverTxResult.Code != code.DuplicateNonce {
+		t.Fatalf("FAIL: DeliverTx of replayed Tx\nExpected code: %d\nActual: %d (%s)", code.DuplicateNonce, deliverTxResult.Code, deliverTxResult.Log)
+	}
+	t.Logf("PASS: nonce replay across upgrade")
+}
+
+func TestLegacyNonceMigration(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+
+	// Raw nonce keys of Txs delivered before upgrade are migrated over more
+	// than one block. Nonces of other Txs sort before nonces of replayed Txs
+	// (base64) so that replayed ones are not migrated in the first block.
+	var nonces [][]byte
+	for i := 0; i < 1500; i++ {
+		nonce := []byte(fmt.Sprintf("!nonce%04d", i))
+		testApp.DB.Set(nonce, []byte{})
+		nonces = append(nonces, nonce)
+	}
+	txs := make(map[string][]byte)
+	for i := 0; i < 3; i++ {
+		tx := local.NewTx("AddNamespace", app.Namespace{Namespace: fmt.Sprintf("namespace%d", i), Description: "Namespace"}, local.NDID, local.NDIDPrivKey, 0)
+		var txObj protoTm.Tx
+		err := proto.Unmarshal(tx, &txObj)
+		if err != nil {
+			t.Fatalf("FAIL: cannot unmarshal Tx: %s", err.Error())
+		}
+		testApp.DB.Set(txObj.Nonce, []byte{})
+		txs[string(txObj.Nonce)] = tx
+		nonces = append(nonces, txObj.Nonce)
+	}
+	// Singleton key with empty value is not a nonce
+	testApp.DB.Set([]byte("MinimumSignatureScheme"), []byte{})
+	testApp.DB.Delete([]byte("LegacyNonceMigrated"))
+	testApp = local.NewApp(t, testApp.DB)
+
+	testApp.EmptyBlocks(1)
+	if testApp.StateValue([]byte("LegacyNonceMigrated")) != nil {
+		t.Fatalf("FAIL: legacy nonces are migrated in one block")
+	}
+	if testApp.StateValue([]byte("LegacyNonceMigrationCursor")) == nil {
+		t.Fatalf("FAIL: no legacy nonce migration cursor")
+	}
+	// Nonce which is not migrated yet is still rejected
+	for nonce, tx := range txs {
+		if testApp.StateValue([]byte(nonce)) == nil {
+			t.Fatalf("FAIL: raw nonce key %s is migrated in the first block", nonce)
+		}
+		checkTxResult := testApp.App.CheckTx(types.RequestCheckTx{Tx: tx})
+		if checkTxResult.Code != code.DuplicateNonce {
+			t.Fatalf("FAIL: CheckTx of replayed Tx\nExpected code: %d\nActual: %d (%s)", code.DuplicateNonce, checkTxResult.Code, checkTxResult.Log)
+		}
+	}
+	testApp.EmptyBlocks(1)
+	if testApp.StateValue([]byte("LegacyNonceMigrated")) == nil {
+		t.Fatalf("FAIL: legacy nonces are not migrated")
+	}
+	for _, nonce := range nonces {
+		if testApp.StateValue(nonce) != nil || testApp.StateValue(append([]byte("Nonce|"), nonce...)) == nil {
+			t.Fatalf("FAIL: raw nonce key %s is not migrated", nonce)
+		}
+	}
+	if testApp.StateValue([]byte("MinimumSignatureScheme")) == nil {
+		t.Fatalf("FAIL: singleton key is migrated as nonce")
+	}
+	for _, tx := range txs {
+		deliverTxResult := testApp.DeliverTx(tx)
+		if deliverTxResult.Code != code.DuplicateNonce {
+			t.Fatalf("FAIL: DeliverTx of replayed Tx\nExpected code: %d\nActual: %d (%s)", code.DuplicateNonce, deliverTxResult.Code, deliverTxResult.Log)
+		}
+	}
+	t.Logf("PASS: legacy nonce migration over blocks")
+}
+
+func TestNonceDefaultExpiry(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	tx := local.NewTx("AddNamespace", app.Namespace{Namespace: "citizenId", Description: "Citizen ID"}, local.NDID, local.NDIDPrivKey, 0)
+	var txObj protoTm.Tx
+	err := proto.Unmarshal(tx, &txObj)
+	if err != nil {
+		t.Fatalf("FAIL: cannot unmarshal Tx: %s", err.Error())
+	}
+	deliverTxResult := testApp.DeliverTx(tx)
+	if deliverTxResult.Code != code.OK {
+		t.Fatalf("FAIL: DeliverTx\nExpected code: %d\nActual: %d (%s)", code.OK, deliverTxResult.Code, deliverTxResult.Log)
+	}
+	// Nonce of Tx without expiry is removed after 1000000 blocks
+	expireKey := fmt.Sprintf("NonceExpire|%020d|%s", testApp.Height+1000000+1, txObj.Nonce)
+	if testApp.StateValue([]byte(expireKey)) == nil {
+		t.Fatalf("FAIL: nonce of Tx without expiry has no default expiry")
+	}
+	t.Logf("PASS: nonce default expiry")
+}
@@ -0,0 +1,139 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package common
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/protos/data"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func newTimeoutTestRequest(requestID string, timeout int) app.CreateRequestParam {
+	return app.CreateRequestParam{
+		RequestID:       requestID,
+		MinIdp:          1,
+		MinAal:          1,
+		MinIal:          1,
+		Timeout:         timeout,
+		IdPIDList:       []string{local.IdP2},
+		DataRequestList: []app.DataRequest{},
+		MessageHash:     "hash",
+		Mode:            1,
+	}
+}
+
+func expectRequestTimedOut(testApp *local.App, requestID string, expected bool) {
+	testApp.T.Helper()
+	var request app.GetRequestResult
+	testApp.QueryResult("GetRequest", app.GetRequestParam{RequestID: requestID}, &request)
+	if request.IsTimedOut != expected {
+		testApp.T.Fatalf("FAIL: Timed out of %s at height %d\nExpected: %t\nActual: %t", requestID, testApp.Height, expected, request.IsTimedOut)
+	}
+}
+
+func TestRequestTimeoutByBlockTime(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	testApp.MustDeliver("CreateRequest", newTimeoutTestRequest("request_1", 5), local.IdP1, local.IdP1PrivKey)
+
+	// Deadline is creation block time + 5 seconds (1 second per block)
+	testApp.EmptyBlocks(4)
+	expectRequestTimedOut(testApp, "request_1", false)
+	testApp.EmptyBlocks(1)
+	expectRequestTimedOut(testApp, "request_1", true)
+	testApp.ExpectBeginBlockEvent("did.request_timed_out", "request_id", "request_1")
+	t.Logf("PASS: request timed out at deadline")
+
+	// Closed request is not timed out
+	testApp.MustDeliver("CreateRequest", newTimeoutTestRequest("request_2", 2), local.IdP1, local.IdP1PrivKey)
+	testApp.MustDeliver("CloseRequest", app.CloseRequestParam{
+		RequestID:         "request_2",
+		ResponseValidList: []app.ResponseValid{},
+	}, local.IdP1, local.IdP1PrivKey)
+	testApp.EmptyBlocks(2)
+	expectRequestTimedOut(testApp, "request_2", false)
+	if local.HasEvent(testApp.BeginBlockEvents, "did.request_timed_out", "request_id", "request_2") {
+		t.Fatalf("FAIL: closed request is timed out")
+	}
+	t.Logf("PASS: closed request is not timed out")
+}
+
+func TestRequestTimeoutIndexBackfill(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+
+	// Requests created before upgrade are not in request timeout index.
+	// Each request has 2 state keys so they are added over more than one block.
+	requestCount := 600
+	for i := 0; i < requestCount; i++ {
+		request := data.Request{
+			RequestId:           fmt.Sprintf("old_request_%03d", i),
+			MinIdp:              1,
+			RequestTimeout:      10,
+			IdpIdList:           []string{local.IdP2},
+			Owner:               local.IdP1,
+			Mode:                1,
+			CreationBlockHeight: 1,
+		}
+		value, _ := proto.Marshal(&request)
+		versions, _ := proto.Marshal(&data.KeyVersions{Versions: []int64{1}})
+		testApp.DB.Set([]byte("Request|"+request.RequestId+"|1"), value)
+		testApp.DB.Set([]byte("Request|"+request.RequestId+"|versions"), versions)
+	}
+	testApp.DB.Delete([]byte("RequestTimeoutIndexBuilt"))
+	blockTime := testApp.BlockTime
+	testApp = local.NewApp(t, testApp.DB)
+	testApp.BlockTime = blockTime
+
+	testApp.EmptyBlocks(1)
+	if testApp.StateValue([]byte("RequestTimeoutIndexBuilt")) != nil {
+		t.Fatalf("FAIL: request timeout index is built in one block")
+	}
+	if testApp.StateValue([]byte("RequestTimeoutIndexCursor")) == nil {
+		t.Fatalf("FAIL: no request timeout index backfill cursor")
+	}
+	// Request created during backfill is indexed on creation only
+	testApp.MustDeliver("CreateRequest", newTimeoutTestRequest("new_request", 20), local.IdP1, local.IdP1PrivKey)
+	if testApp.StateValue([]byte("RequestTimeoutIndexBuilt")) == nil {
+		t.Fatalf("FAIL: request timeout index is not built")
+	}
+	t.Logf("PASS: request timeout index backfill over blocks")
+
+	// Deadline of requests in the first chunk is counted from the first block
+	// after upgrade and the rest from the next block
+	testApp.EmptyBlocks(8)
+	expectRequestTimedOut(testApp, "old_request_000", false)
+	testApp.EmptyBlocks(1)
+	expectRequestTimedOut(testApp, "old_request_000", true)
+	expectRequestTimedOut(testApp, fmt.Sprintf("old_request_%03d", requestCount-1), false)
+	testApp.EmptyBlocks(1)
+	expectRequestTimedOut(testApp, fmt.Sprintf("old_request_%03d", requestCount-1), true)
+	testApp.EmptyBlocks(9)
+	expectRequestTimedOut(testApp, "new_request", false)
+	testApp.EmptyBlocks(1)
+	expectRequestTimedOut(testApp, "new_request", true)
+	t.Logf("PASS: backfilled requests time out")
+}
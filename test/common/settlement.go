@@ -0,0 +1,83 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestRequestSettlement(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	newRequest := func(requestID string, timeout int) app.CreateRequestParam {
+		return app.CreateRequestParam{
+			RequestID:       requestID,
+			MinIdp:          2,
+			MinAal:          1,
+			MinIal:          1,
+			Timeout:         timeout,
+			IdPIDList:       []string{local.IdP2},
+			DataRequestList: []app.DataRequest{},
+			MessageHash:     "hash",
+			Mode:            1,
+		}
+	}
+	valid := true
+
+	testApp.MustDeliver("SetIdpResponseFee", app.SetIdpResponseFeeParam{Fee: "10"}, local.NDID, local.NDIDPrivKey)
+
+	// Fee of 2 responses is escrowed from request owner (Tx fee is 1 token)
+	testApp.MustDeliver("CreateRequest", newRequest("request_1", 1000), local.IdP1, local.IdP1PrivKey)
+	testApp.ExpectToken(local.IdP1, "79")
+	testApp.MustDeliver("CreateIdpResponse", app.CreateIdpResponseParam{
+		Aal:       3,
+		Ial:       3,
+		RequestID: "request_1",
+		Signature: "signature",
+		Status:    "accept",
+	}, local.IdP2, local.IdP2PrivKey)
+	testApp.ExpectToken(local.IdP2, "99")
+
+	// Closing request pays responded IdP and refunds the rest to request owner
+	testApp.MustDeliver("CloseRequest", app.CloseRequestParam{
+		RequestID: "request_1",
+		ResponseValidList: []app.ResponseValid{
+			{IdpID: local.IdP2, ValidIal: &valid, ValidSignature: &valid},
+		},
+	}, local.IdP1, local.IdP1PrivKey)
+	testApp.ExpectToken(local.IdP1, "88")
+	testApp.ExpectToken(local.IdP2, "109")
+
+	// Timed out request without response is refunded in full
+	testApp.MustDeliver("CreateRequest", newRequest("request_2", 3), local.IdP1, local.IdP1PrivKey)
+	testApp.ExpectToken(local.IdP1, "67")
+	testApp.EmptyBlocks(3)
+	var request app.GetRequestResult
+	testApp.QueryResult("GetRequest", app.GetRequestParam{RequestID: "request_2"}, &request)
+	if !request.IsTimedOut {
+		t.Fatalf("FAIL: Request is not timed out after request timeout")
+	}
+	testApp.ExpectToken(local.IdP1, "87")
+}
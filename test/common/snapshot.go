@@ -0,0 +1,91 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestStateSnapshot(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	oldHeight := testApp.Height
+	testApp.MustDeliver("SetNodeToken", map[string]interface{}{
+		"node_id": local.IdP1,
+		"amount":  50,
+	}, local.NDID, local.NDIDPrivKey)
+
+	// Snapshot at current height is verified on import
+	var buf bytes.Buffer
+	_, err := app.ExportState(testApp.DB, 0, &buf)
+	if err != nil {
+		t.Fatalf("FAIL: ExportState: %s", err.Error())
+	}
+	db := dbm.NewMemDB()
+	metadata, err := app.ImportState(db, &buf, false)
+	if err != nil {
+		t.Fatalf("FAIL: ImportState: %s", err.Error())
+	}
+	if metadata.Height != testApp.Height || !bytes.Equal(metadata.AppHash, testApp.AppHash()) {
+		t.Fatalf("FAIL: imported state\nExpected: %d %X\nActual: %d %X", testApp.Height, testApp.AppHash(), metadata.Height, metadata.AppHash)
+	}
+	local.NewApp(t, db).ExpectToken(local.IdP1, "50")
+	t.Logf("PASS: snapshot at current height")
+
+	// Snapshot at an older height has versioned keys at that height
+	// and can only be imported in migration mode
+	buf.Reset()
+	_, err = app.ExportState(testApp.DB, oldHeight, &buf)
+	if err != nil {
+		t.Fatalf("FAIL: ExportState at height %d: %s", oldHeight, err.Error())
+	}
+	snapshot := buf.Bytes()
+	_, err = app.ImportState(dbm.NewMemDB(), bytes.NewReader(snapshot), false)
+	if err == nil {
+		t.Fatalf("FAIL: snapshot at an older height is imported without migration mode")
+	}
+	db = dbm.NewMemDB()
+	metadata, err = app.ImportState(db, bytes.NewReader(snapshot), true)
+	if err != nil {
+		t.Fatalf("FAIL: ImportState in migration mode: %s", err.Error())
+	}
+	if metadata.Height != oldHeight || metadata.StateTreeHeight != oldHeight {
+		t.Fatalf("FAIL: migrated state height\nExpected: %d\nActual: %d (state tree height %d)", oldHeight, metadata.Height, metadata.StateTreeHeight)
+	}
+	migratedApp := local.NewApp(t, db)
+	if !bytes.Equal(migratedApp.AppHash(), metadata.AppHash) {
+		t.Fatalf("FAIL: app hash of migrated state\nExpected: %X\nActual: %X", metadata.AppHash, migratedApp.AppHash())
+	}
+	migratedApp.ExpectToken(local.IdP1, "100")
+	migratedApp.MustDeliver("SetNodeToken", map[string]interface{}{
+		"node_id": local.IdP1,
+		"amount":  10,
+	}, local.NDID, local.NDIDPrivKey)
+	migratedApp.ExpectToken(local.IdP1, "10")
+	t.Logf("PASS: snapshot at an older height in migration mode")
+}
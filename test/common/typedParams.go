@@ -0,0 +1,209 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package common
+
+import (
+	"crypto/rsa"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	protoParams "github.com/ndidplatform/smart-contract/v4/protos/params"
+	"github.com/ndidplatform/smart-contract/v4/test/data"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+	"github.com/ndidplatform/smart-contract/v4/test/utils"
+)
+
+func TestTypedTokenAmount(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+
+	// Typed params signed with local.NDID key
+	txBytes := local.NewTypedTx("AddNodeToken", &protoParams.TxParams{
+		Params: &protoParams.TxParams_AddNodeToken{
+			AddNodeToken: &protoParams.AddNodeTokenParams{NodeId: local.IdP1, Amount: "10.5"},
+		},
+	}, local.NDID, local.NDIDPrivKey)
+	resultCode, resultLog := testApp.CheckAndDeliverTx(txBytes)
+	if resultCode != code.OK {
+		t.Fatalf("FAIL: AddNodeToken with typed params\nExpected code: %d\nActual: %d (%s)", code.OK, resultCode, resultLog)
+	}
+
+	// Token amount in JSON form may be number or string
+	testApp.MustDeliver("AddNodeToken", map[string]interface{}{
+		"node_id": local.IdP1,
+		"amount":  1,
+	}, local.NDID, local.NDIDPrivKey)
+	testApp.MustDeliver("AddNodeToken", map[string]interface{}{
+		"node_id": local.IdP1,
+		"amount":  "0.5",
+	}, local.NDID, local.NDIDPrivKey)
+
+	// Typed result has token amount as decimal string
+	res := testApp.TypedQuery("GetNodeToken", &protoParams.QueryParams{
+		Params: &protoParams.QueryParams_GetNodeToken{
+			GetNodeToken: &protoParams.GetNodeTokenParams{NodeId: local.IdP1},
+		},
+	})
+	var result protoParams.GetNodeTokenResult
+	err := proto.Unmarshal(res.Value, &result)
+	if err != nil {
+		t.Fatalf("FAIL: GetNodeToken with typed params: %s (%s)", err.Error(), res.Log)
+	}
+	if result.Amount != "112" {
+		t.Fatalf("FAIL: GetNodeToken with typed params\nExpected amount: 112\nActual: %q (%s)", result.Amount, res.Log)
+	}
+}
+
+func TestTypedParamsUnknownField(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+
+	// Unknown field of JSON params is ignored
+	testApp.MustDeliver("AddNodeToken", map[string]interface{}{
+		"node_id": local.IdP1,
+		"amount":  "1",
+		"unknown": 1,
+	}, local.NDID, local.NDIDPrivKey)
+
+	// Typed params with field that is not in the schema (field 15, varint 1)
+	txBytes := local.NewTypedTx("AddNodeToken", &protoParams.TxParams{
+		Params: &protoParams.TxParams_AddNodeToken{
+			AddNodeToken: &protoParams.AddNodeTokenParams{
+				NodeId:           local.IdP1,
+				Amount:           "1",
+				XXX_unrecognized: []byte{15 << 3, 1},
+			},
+		},
+	}, local.NDID, local.NDIDPrivKey)
+	resultCode, resultLog := testApp.CheckAndDeliverTx(txBytes)
+	if resultCode != code.UnknownFieldInParams {
+		t.Fatalf("FAIL: AddNodeToken with unknown field in typed params\nExpected code: %d\nActual: %d (%s)", code.UnknownFieldInParams, resultCode, resultLog)
+	}
+	testApp.ExpectToken(local.IdP1, "101")
+}
+
+func TestTypedAssuranceLevels(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	publicKey := local.PublicKeyPEM(utils.GetPrivateKeyFromString(data.AsPrivK2))
+	registerNode := func(nodeID string, maxIal string, maxAal string) (uint32, string) {
+		return testApp.CheckAndDeliverTx(local.NewTypedTx("RegisterNode", &protoParams.TxParams{
+			Params: &protoParams.TxParams_RegisterNode{
+				RegisterNode: &protoParams.RegisterNodeParams{
+					NodeId:          nodeID,
+					PublicKey:       publicKey,
+					MasterPublicKey: publicKey,
+					NodeName:        nodeID,
+					Role:            "IdP",
+					MaxIal:          maxIal,
+					MaxAal:          maxAal,
+				},
+			},
+		}, local.NDID, local.NDIDPrivKey))
+	}
+
+	if resultCode, resultLog := registerNode("idp3", "2.3", "3"); resultCode != code.OK {
+		t.Fatalf("FAIL: RegisterNode with typed params\nExpected code: %d\nActual: %d (%s)", code.OK, resultCode, resultLog)
+	}
+	if resultCode, _ := registerNode("idp4", "2,3", "3"); resultCode != code.InvalidTypedParams {
+		t.Fatalf("FAIL: RegisterNode with invalid IAL in typed params\nExpected code: %d\nActual: %d", code.InvalidTypedParams, resultCode)
+	}
+
+	// Assurance levels are numbers in JSON result and decimal strings in typed result
+	var nodeInfo app.GetNodeInfoIdPResult
+	testApp.QueryResult("GetNodeInfo", app.GetNodeInfoParam{NodeID: "idp3"}, &nodeInfo)
+	if nodeInfo.MaxIal != 2.3 || nodeInfo.MaxAal != 3 {
+		t.Fatalf("FAIL: GetNodeInfo\nExpected: 2.3, 3\nActual: %v, %v", nodeInfo.MaxIal, nodeInfo.MaxAal)
+	}
+	res := testApp.TypedQuery("GetNodeInfo", &protoParams.QueryParams{
+		Params: &protoParams.QueryParams_GetNodeInfo{
+			GetNodeInfo: &protoParams.GetNodeInfoParams{NodeId: "idp3"},
+		},
+	})
+	var result protoParams.GetNodeInfoResult
+	err := proto.Unmarshal(res.Value, &result)
+	if err != nil {
+		t.Fatalf("FAIL: GetNodeInfo with typed params: %s (%s)", err.Error(), res.Log)
+	}
+	if result.MaxIal != "2.3" || result.MaxAal != "3" {
+		t.Fatalf("FAIL: GetNodeInfo with typed params\nExpected: \"2.3\", \"3\"\nActual: %q, %q", result.MaxIal, result.MaxAal)
+	}
+
+	// Request with assurance levels
+	testApp.MustDeliver("SetNodeToken", map[string]interface{}{
+		"node_id": "idp3",
+		"amount":  100,
+	}, local.NDID, local.NDIDPrivKey)
+	typedTxs := []struct {
+		method      string
+		typedParams *protoParams.TxParams
+		nodeID      string
+		privKey     *rsa.PrivateKey
+	}{
+		{"CreateRequest", &protoParams.TxParams{
+			Params: &protoParams.TxParams_CreateRequest{
+				CreateRequest: &protoParams.CreateRequestParams{
+					RequestId:          "typed-request",
+					MinIdp:             1,
+					MinAal:             "2.5",
+					MinIal:             "2.3",
+					RequestTimeout:     1000,
+					IdpIdList:          []string{"idp3"},
+					RequestMessageHash: "hash",
+					Mode:               3,
+				},
+			},
+		}, local.IdP1, local.IdP1PrivKey},
+		{"CreateIdpResponse", &protoParams.TxParams{
+			Params: &protoParams.TxParams_CreateIdpResponse{
+				CreateIdpResponse: &protoParams.CreateIdpResponseParams{
+					Aal:       "3",
+					Ial:       "2.3",
+					RequestId: "typed-request",
+					Signature: "signature",
+					Status:    "accept",
+				},
+			},
+		}, "idp3", utils.GetPrivateKeyFromString(data.AsPrivK2)},
+	}
+	for _, typedTx := range typedTxs {
+		resultCode, resultLog := testApp.CheckAndDeliverTx(local.NewTypedTx(typedTx.method, typedTx.typedParams, typedTx.nodeID, typedTx.privKey))
+		if resultCode != code.OK {
+			t.Fatalf("FAIL: %s with typed params\nExpected code: %d\nActual: %d (%s)", typedTx.method, code.OK, resultCode, resultLog)
+		}
+	}
+	res = testApp.TypedQuery("GetRequestDetail", &protoParams.QueryParams{
+		Params: &protoParams.QueryParams_GetRequestDetail{
+			GetRequestDetail: &protoParams.GetRequestDetailParams{RequestId: "typed-request"},
+		},
+	})
+	var requestDetail protoParams.GetRequestDetailResult
+	err = proto.Unmarshal(res.Value, &requestDetail)
+	if err != nil {
+		t.Fatalf("FAIL: GetRequestDetail with typed params: %s (%s)", err.Error(), res.Log)
+	}
+	if requestDetail.MinIal != "2.3" || requestDetail.MinAal != "2.5" ||
+		len(requestDetail.ResponseList) != 1 || requestDetail.ResponseList[0].Ial != "2.3" {
+		t.Fatalf("FAIL: GetRequestDetail with typed params\nActual: %+v", requestDetail)
+	}
+}
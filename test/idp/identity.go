@@ -0,0 +1,120 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package idp
+
+import (
+	"crypto/rsa"
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+// Namespaces added by newIdentityTestApp
+const (
+	// At most one identifier in a reference group
+	citizenIDNamespace = "citizen_id"
+	// At most two identifiers in a reference group and one of them active
+	passportNamespace = "passport"
+	// No limit of identifiers in a reference group
+	emailNamespace = "email"
+)
+
+// newIdentityTestApp returns initialized app with identity namespaces
+func newIdentityTestApp(t *testing.T) *local.App {
+	testApp := local.NewInitializedApp(t)
+	testApp.MustDeliver("AddNamespace", app.Namespace{
+		Namespace:                              citizenIDNamespace,
+		Description:                            "Citizen ID",
+		AllowedIdentifierCountInReferenceGroup: 1,
+	}, local.NDID, local.NDIDPrivKey)
+	testApp.MustDeliver("AddNamespace", app.Namespace{
+		Namespace:                              passportNamespace,
+		Description:                            "Passport",
+		AllowedIdentifierCountInReferenceGroup: 2,
+		AllowedActiveIdentifierCountInReferenceGroup: 1,
+	}, local.NDID, local.NDIDPrivKey)
+	testApp.MustDeliver("AddNamespace", app.Namespace{
+		Namespace:   emailNamespace,
+		Description: "Email",
+	}, local.NDID, local.NDIDPrivKey)
+	return testApp
+}
+
+func newRegisterIdentityParam(refGroupCode, namespace, identifierHash, accessorID string, modeList ...int32) app.RegisterIdentityParam {
+	return app.RegisterIdentityParam{
+		ReferenceGroupCode: refGroupCode,
+		NewIdentityList: []app.Identity{
+			{IdentityNamespace: namespace, IdentityIdentifierHash: identifierHash},
+		},
+		Ial:               3,
+		ModeList:          modeList,
+		AccessorID:        accessorID,
+		AccessorPublicKey: local.PublicKeyPEM(local.AS1PrivKey),
+		AccessorType:      "RSA2048",
+	}
+}
+
+// completeRequest creates request of purpose owned by ownerID which is
+// accepted by responderID and closes it
+func completeRequest(testApp *local.App, requestID, purpose, ownerID string, ownerPrivKey *rsa.PrivateKey, responderID string, responderPrivKey *rsa.PrivateKey) {
+	testApp.T.Helper()
+	valid := true
+	testApp.MustDeliver("CreateRequest", app.CreateRequestParam{
+		RequestID:       requestID,
+		MinIdp:          1,
+		MinAal:          1,
+		MinIal:          1,
+		Timeout:         1000,
+		IdPIDList:       []string{responderID},
+		DataRequestList: []app.DataRequest{},
+		MessageHash:     "hash",
+		Purpose:         purpose,
+		Mode:            3,
+	}, ownerID, ownerPrivKey)
+	testApp.MustDeliver("CreateIdpResponse", app.CreateIdpResponseParam{
+		Aal:       3,
+		Ial:       3,
+		RequestID: requestID,
+		Signature: "signature",
+		Status:    "accept",
+	}, responderID, responderPrivKey)
+	testApp.MustDeliver("CloseRequest", app.CloseRequestParam{
+		RequestID: requestID,
+		ResponseValidList: []app.ResponseValid{
+			{IdpID: responderID, ValidIal: &valid, ValidSignature: &valid},
+		},
+	}, ownerID, ownerPrivKey)
+}
+
+func expectRefGroupCode(testApp *local.App, namespace, identifierHash, expected string) {
+	testApp.T.Helper()
+	var result app.GetReferenceGroupCodeResult
+	testApp.QueryResult("GetReferenceGroupCode", app.GetReferenceGroupCodeParam{
+		IdentityNamespace:      namespace,
+		IdentityIdentifierHash: identifierHash,
+	}, &result)
+	if result.ReferenceGroupCode != expected {
+		testApp.T.Fatalf("FAIL: Reference group code of %s %s\nExpected: %s\nActual: %s", namespace, identifierHash, expected, result.ReferenceGroupCode)
+	}
+}
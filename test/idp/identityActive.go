@@ -0,0 +1,65 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package idp
+
+import (
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestSetIdentityActive(t *testing.T) {
+	testApp := newIdentityTestApp(t)
+	testApp.MustDeliver("RegisterIdentity", newRegisterIdentityParam("ref_a", passportNamespace, "old_passport", "accessor_a", 2), local.IdP1, local.IdP1PrivKey)
+	newPassport := app.AddIdentityParam{
+		ReferenceGroupCode: "ref_a",
+		NewIdentityList: []app.Identity{
+			{IdentityNamespace: passportNamespace, IdentityIdentifierHash: "new_passport"},
+		},
+	}
+	passport := func(identifierHash string) app.ActivateIdentityParam {
+		return app.ActivateIdentityParam{
+			IdentityNamespace:      passportNamespace,
+			IdentityIdentifierHash: identifierHash,
+		}
+	}
+
+	// Only one passport may be active
+	testApp.ExpectDeliver("AddIdentity", newPassport, local.IdP1, local.IdP1PrivKey, code.ActiveIdentifierCountIsGreaterThanAllowedCount)
+	testApp.MustDeliver("DeactivateIdentity", app.DeactivateIdentityParam(passport("old_passport")), local.IdP1, local.IdP1PrivKey)
+	testApp.MustDeliver("AddIdentity", newPassport, local.IdP1, local.IdP1PrivKey)
+	testApp.ExpectDeliver("ActivateIdentity", passport("old_passport"), local.IdP1, local.IdP1PrivKey, code.ActiveIdentifierCountIsGreaterThanAllowedCount)
+	t.Logf("PASS: active identifier count")
+
+	// Last active identity can be deactivated
+	testApp.MustDeliver("DeactivateIdentity", app.DeactivateIdentityParam(passport("new_passport")), local.IdP1, local.IdP1PrivKey)
+	testApp.MustDeliver("ActivateIdentity", passport("old_passport"), local.IdP1, local.IdP1PrivKey)
+	t.Logf("PASS: deactivate last active identity")
+
+	// IdP must be associated with identity
+	testApp.ExpectDeliver("DeactivateIdentity", app.DeactivateIdentityParam(passport("old_passport")), local.IdP2, local.IdP2PrivKey, code.IdentityNotFoundInThisIdP)
+	testApp.ExpectDeliver("ActivateIdentity", passport("unknown_passport"), local.IdP1, local.IdP1PrivKey, code.RefGroupNotFound)
+	t.Logf("PASS: set identity active of other IdP")
+}
@@ -0,0 +1,103 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package idp
+
+import (
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestUpdateAndWithdrawIdpResponse(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	requestID := "request_update_withdraw"
+	testApp.MustDeliver("CreateRequest", app.CreateRequestParam{
+		RequestID:       requestID,
+		MinIdp:          1,
+		MinAal:          1,
+		MinIal:          2,
+		Timeout:         1000,
+		IdPIDList:       []string{local.IdP2},
+		DataRequestList: []app.DataRequest{},
+		MessageHash:     "hash",
+		Mode:            3,
+	}, local.IdP1, local.IdP1PrivKey)
+	response := func(ial float64, status string) app.UpdateIdpResponseParam {
+		return app.UpdateIdpResponseParam{
+			Aal:       3,
+			Ial:       ial,
+			RequestID: requestID,
+			Signature: "signature_" + status,
+			Status:    status,
+		}
+	}
+	withdraw := app.WithdrawIdpResponseParam{RequestID: requestID}
+	expectResponses := func(expectedStatus []string, expectedActions []string) {
+		t.Helper()
+		var detail app.GetRequestDetailResult
+		testApp.QueryResult("GetRequestDetail", app.GetRequestParam{RequestID: requestID}, &detail)
+		if len(detail.Responses) != len(expectedStatus) || len(detail.ResponseHistory) != len(expectedActions) {
+			t.Fatalf("FAIL: GetRequestDetail\nExpected: %v %v\nActual: %+v %+v", expectedStatus, expectedActions, detail.Responses, detail.ResponseHistory)
+		}
+		for index, status := range expectedStatus {
+			if detail.Responses[index].Status != status {
+				t.Fatalf("FAIL: GetRequestDetail\nExpected status: %v\nActual: %+v", expectedStatus, detail.Responses)
+			}
+		}
+		for index, action := range expectedActions {
+			if detail.ResponseHistory[index].Action != action {
+				t.Fatalf("FAIL: GetRequestDetail\nExpected actions: %v\nActual: %+v", expectedActions, detail.ResponseHistory)
+			}
+		}
+	}
+
+	// IdP without response cannot update or withdraw
+	testApp.ExpectDeliver("UpdateIdpResponse", response(3, "accept"), local.IdP2, local.IdP2PrivKey, code.IdpResponseNotFound)
+	testApp.ExpectDeliver("WithdrawIdpResponse", withdraw, local.IdP2, local.IdP2PrivKey, code.IdpResponseNotFound)
+
+	testApp.MustDeliver("CreateIdpResponse", app.CreateIdpResponseParam(response(3, "accept")), local.IdP2, local.IdP2PrivKey)
+	testApp.MustDeliver("UpdateIdpResponse", response(2.5, "reject"), local.IdP2, local.IdP2PrivKey)
+	testApp.ExpectDeliver("UpdateIdpResponse", response(1.5, "accept"), local.IdP2, local.IdP2PrivKey, code.IALError)
+	expectResponses([]string{"reject"}, []string{"create", "update"})
+
+	testApp.MustDeliver("WithdrawIdpResponse", withdraw, local.IdP2, local.IdP2PrivKey)
+	testApp.ExpectDeliver("WithdrawIdpResponse", withdraw, local.IdP2, local.IdP2PrivKey, code.IdpResponseNotFound)
+	expectResponses([]string{}, []string{"create", "update", "withdraw"})
+	t.Logf("PASS: Update and withdraw IdP response")
+
+	// IdP can respond again after withdrawing until request is closed
+	testApp.MustDeliver("CreateIdpResponse", app.CreateIdpResponseParam(response(3, "accept")), local.IdP2, local.IdP2PrivKey)
+	valid := true
+	testApp.MustDeliver("CloseRequest", app.CloseRequestParam{
+		RequestID: requestID,
+		ResponseValidList: []app.ResponseValid{
+			{IdpID: local.IdP2, ValidIal: &valid, ValidSignature: &valid},
+		},
+	}, local.IdP1, local.IdP1PrivKey)
+	testApp.ExpectDeliver("UpdateIdpResponse", response(3, "reject"), local.IdP2, local.IdP2PrivKey, code.RequestIsClosed)
+	testApp.ExpectDeliver("WithdrawIdpResponse", withdraw, local.IdP2, local.IdP2PrivKey, code.RequestIsClosed)
+	expectResponses([]string{"accept"}, []string{"create", "update", "withdraw", "create"})
+	t.Logf("PASS: Update and withdraw IdP response of closed request")
+}
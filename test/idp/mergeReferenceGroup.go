@@ -0,0 +1,79 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package idp
+
+import (
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestMergeReferenceGroup(t *testing.T) {
+	testApp := newIdentityTestApp(t)
+	testApp.MustDeliver("RegisterIdentity", newRegisterIdentityParam("ref_a", citizenIDNamespace, "citizen_a", "accessor_a", 2), local.IdP1, local.IdP1PrivKey)
+	testApp.MustDeliver("RegisterIdentity", newRegisterIdentityParam("ref_b", passportNamespace, "passport_b", "accessor_b", 2), local.IdP1, local.IdP1PrivKey)
+	testApp.MustDeliver("RegisterIdentity", newRegisterIdentityParam("ref_c", citizenIDNamespace, "citizen_c", "accessor_c", 2), local.IdP2, local.IdP2PrivKey)
+
+	// IdP must be associated with identity in both groups
+	testApp.ExpectDeliver("MergeReferenceGroup", app.MergeReferenceGroupParam{
+		ReferenceGroupCode:        "ref_a",
+		ReferenceGroupCodeToMerge: "ref_c",
+	}, local.IdP1, local.IdP1PrivKey, code.IdentityNotFoundInThisIdP)
+
+	testApp.MustDeliver("MergeReferenceGroup", app.MergeReferenceGroupParam{
+		IdentityNamespace:         citizenIDNamespace,
+		IdentityIdentifierHash:    "citizen_a",
+		ReferenceGroupCodeToMerge: "ref_b",
+	}, local.IdP1, local.IdP1PrivKey)
+	expectRefGroupCode(testApp, passportNamespace, "passport_b", "ref_a")
+	var result app.GetReferenceGroupCodeResult
+	testApp.QueryResult("GetReferenceGroupCodeByAccessorID", app.GetReferenceGroupCodeByAccessorIDParam{AccessorID: "accessor_b"}, &result)
+	if result.ReferenceGroupCode != "ref_a" {
+		t.Fatalf("FAIL: Reference group code of accessor_b\nExpected: ref_a\nActual: %s", result.ReferenceGroupCode)
+	}
+	t.Logf("PASS: merge reference group")
+
+	// Merged group code is a tombstone redirecting to the remaining group
+	testApp.ExpectDeliver("MergeReferenceGroup", app.MergeReferenceGroupParam{
+		ReferenceGroupCode:        "ref_a",
+		ReferenceGroupCodeToMerge: "ref_b",
+	}, local.IdP1, local.IdP1PrivKey, code.CannotMergeSameReferenceGroup)
+	testApp.MustDeliver("AddIdentity", app.AddIdentityParam{
+		ReferenceGroupCode: "ref_b",
+		NewIdentityList: []app.Identity{
+			{IdentityNamespace: emailNamespace, IdentityIdentifierHash: "email_b"},
+		},
+	}, local.IdP1, local.IdP1PrivKey)
+	expectRefGroupCode(testApp, emailNamespace, "email_b", "ref_a")
+	t.Logf("PASS: merged reference group tombstone")
+
+	// NDID can merge any groups within namespace identifier count
+	testApp.ExpectDeliver("MergeReferenceGroup", app.MergeReferenceGroupParam{
+		ReferenceGroupCode:        "ref_a",
+		ReferenceGroupCodeToMerge: "ref_c",
+	}, local.NDID, local.NDIDPrivKey, code.IdentifierCountIsGreaterThanAllowedIdentifierCount)
+	expectRefGroupCode(testApp, citizenIDNamespace, "citizen_c", "ref_c")
+	t.Logf("PASS: merge reference group over allowed identifier count")
+}
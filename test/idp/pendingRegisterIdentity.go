@@ -0,0 +1,89 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package idp
+
+import (
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func expectIdentityIal(testApp *local.App, refGroupCode, nodeID string, expected float64) {
+	testApp.T.Helper()
+	var result app.GetIdentityInfoResult
+	testApp.QueryResult("GetIdentityInfo", app.GetIdentityInfoParam{
+		ReferenceGroupCode: refGroupCode,
+		NodeID:             nodeID,
+	}, &result)
+	if result.Ial != expected {
+		testApp.T.Fatalf("FAIL: IAL of %s in %s\nExpected: %v\nActual: %v", nodeID, refGroupCode, expected, result.Ial)
+	}
+}
+
+func TestPendingRegisterIdentity(t *testing.T) {
+	testApp := newIdentityTestApp(t)
+	timeOutBlock := int64(10)
+	testApp.MustDeliver("SetTimeOutBlockRegisterIdentity", app.TimeOutBlockRegisterIdentity{TimeOutBlock: timeOutBlock}, local.NDID, local.NDIDPrivKey)
+	testApp.MustDeliver("RegisterIdentity", newRegisterIdentityParam("ref_a", citizenIDNamespace, "citizen_a", "accessor_a", 2, 3), local.IdP1, local.IdP1PrivKey)
+
+	// Mode 3 registration at a group with an active IdP without consent
+	// request only reserves its identities and accessor
+	testApp.MustDeliver("RegisterIdentity", newRegisterIdentityParam("ref_a", emailNamespace, "email_1", "accessor_1", 3), local.IdP2, local.IdP2PrivKey)
+	registerHeight := testApp.Height
+	expectIdentityIal(testApp, "ref_a", local.IdP2, 0)
+	expectRefGroupCode(testApp, emailNamespace, "email_1", "ref_a")
+	testApp.ExpectDeliver("RegisterIdentity", newRegisterIdentityParam("ref_a", emailNamespace, "email_2", "accessor_1", 3), local.IdP2, local.IdP2PrivKey, code.DuplicateAccessorID)
+
+	// Registration is reverted in BeginBlock of time out block
+	testApp.EmptyBlocks(int(registerHeight + timeOutBlock - testApp.Height - 1))
+	expectRefGroupCode(testApp, emailNamespace, "email_1", "ref_a")
+	testApp.EmptyBlocks(1)
+	testApp.ExpectBeginBlockEvent("did.register_identity_timed_out", "accessor_id", "accessor_1")
+	expectRefGroupCode(testApp, emailNamespace, "email_1", "")
+	testApp.ExpectDeliver("ClearRegisterIdentityTimeout", app.ClearRegisterIdentityTimeoutParam{
+		AccessorID: "accessor_1",
+	}, local.IdP2, local.IdP2PrivKey, code.PendingRegisterIdentityNotFound)
+	t.Logf("PASS: pending registration timed out")
+
+	// Cleared with a completed request before time out block
+	testApp.MustDeliver("RegisterIdentity", newRegisterIdentityParam("ref_a", emailNamespace, "email_1", "accessor_1", 3), local.IdP2, local.IdP2PrivKey)
+	completeRequest(testApp, "request_1", "RegisterIdentity", local.IdP2, local.IdP2PrivKey, local.IdP1, local.IdP1PrivKey)
+	testApp.ExpectDeliver("ClearRegisterIdentityTimeout", app.ClearRegisterIdentityTimeoutParam{
+		AccessorID: "accessor_1",
+		RequestID:  "request_1",
+	}, local.IdP1, local.IdP1PrivKey, code.PendingRegisterIdentityNotFound)
+	testApp.ExpectDeliver("ClearRegisterIdentityTimeout", app.ClearRegisterIdentityTimeoutParam{
+		AccessorID: "accessor_1",
+		RequestID:  "unknown_request",
+	}, local.IdP2, local.IdP2PrivKey, code.RequestIDNotFound)
+	testApp.MustDeliver("ClearRegisterIdentityTimeout", app.ClearRegisterIdentityTimeoutParam{
+		AccessorID: "accessor_1",
+		RequestID:  "request_1",
+	}, local.IdP2, local.IdP2PrivKey)
+	expectIdentityIal(testApp, "ref_a", local.IdP2, 3)
+	testApp.EmptyBlocks(int(timeOutBlock))
+	expectRefGroupCode(testApp, emailNamespace, "email_1", "ref_a")
+	t.Logf("PASS: clear pending registration")
+}
@@ -0,0 +1,501 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+// Package local runs the ABCI app in process on an in-memory DB so that
+// block level behavior (BeginBlock, Commit, state across upgrade) can be
+// tested without a running Tendermint node. Tests using it are in the
+// package of the role they exercise (test/ndid, test/common, ...).
+package local
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/gogo/protobuf/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	protoData "github.com/ndidplatform/smart-contract/v4/protos/data"
+	protoParams "github.com/ndidplatform/smart-contract/v4/protos/params"
+	protoTm "github.com/ndidplatform/smart-contract/v4/protos/tendermint"
+	"github.com/ndidplatform/smart-contract/v4/test/data"
+	"github.com/ndidplatform/smart-contract/v4/test/utils"
+	"golang.org/x/crypto/ed25519"
+)
+
+// ChainID is chain ID of blocks run by App
+const ChainID = "test-chain"
+
+// Node IDs of nodes registered by NewInitializedApp
+const (
+	NDID = "ndid"
+	IdP1 = "idp1"
+	IdP2 = "idp2"
+	AS1  = "as1"
+)
+
+var (
+	NDIDPrivKey = utils.GetPrivateKeyFromString(data.NdidPrivK)
+	IdP1PrivKey = utils.GetPrivateKeyFromString(data.IdpPrivK1)
+	IdP2PrivKey = utils.GetPrivateKeyFromString(data.IdpPrivK2)
+	AS1PrivKey  = utils.GetPrivateKeyFromString(data.AsPrivK1)
+)
+
+// App is ABCI app running in process with height and time of its latest block
+type App struct {
+	T         *testing.T
+	DB        dbm.DB
+	App       *app.ABCIApplication
+	Height    int64
+	BlockTime time.Time
+	// Events of BeginBlock of the latest block
+	BeginBlockEvents []types.Event
+}
+
+// NewApp starts ABCI app on db (which may contain state of a previous app)
+func NewApp(t *testing.T, db dbm.DB) *App {
+	logger := logrus.New()
+	logger.Out = ioutil.Discard
+	abciApp := app.NewABCIApplication(logrus.NewEntry(logger), db)
+	info := abciApp.Info(types.RequestInfo{})
+	return &App{
+		T:         t,
+		DB:        db,
+		App:       abciApp,
+		Height:    info.LastBlockHeight,
+		BlockTime: time.Unix(1500000000, 0).Add(time.Duration(info.LastBlockHeight) * time.Second),
+	}
+}
+
+// NewInitializedApp returns app with NDID, two IdPs and an AS registered
+// with 100 tokens each
+func NewInitializedApp(t *testing.T) *App {
+	testApp := NewApp(t, dbm.NewMemDB())
+	ndidPublicKey := PublicKeyPEM(NDIDPrivKey)
+	testApp.MustDeliver("InitNDID", app.InitNDIDParam{
+		NodeID:          NDID,
+		PublicKey:       ndidPublicKey,
+		MasterPublicKey: ndidPublicKey,
+	}, NDID, NDIDPrivKey)
+	testApp.MustDeliver("EndInit", app.EndInitParam{}, NDID, NDIDPrivKey)
+	nodes := []struct {
+		nodeID  string
+		role    string
+		privKey *rsa.PrivateKey
+	}{
+		{IdP1, "IdP", IdP1PrivKey},
+		{IdP2, "IdP", IdP2PrivKey},
+		{AS1, "AS", AS1PrivKey},
+	}
+	for _, node := range nodes {
+		publicKey := PublicKeyPEM(node.privKey)
+		testApp.MustDeliver("RegisterNode", app.RegisterNode{
+			NodeID:          node.nodeID,
+			PublicKey:       publicKey,
+			MasterPublicKey: publicKey,
+			NodeName:        node.nodeID,
+			Role:            node.role,
+			MaxIal:          3,
+			MaxAal:          3,
+		}, NDID, NDIDPrivKey)
+		testApp.MustDeliver("SetNodeToken", map[string]interface{}{
+			"node_id": node.nodeID,
+			"amount":  100,
+		}, NDID, NDIDPrivKey)
+	}
+	return testApp
+}
+
+func PublicKeyPEM(privKey *rsa.PrivateKey) string {
+	publicKey, err := utils.GeneratePublicKey(&privKey.PublicKey)
+	if err != nil {
+		panic(err)
+	}
+	return string(publicKey)
+}
+
+// PKIXPublicKeyPEM returns PEM encoded PKIX public key of privKey which is
+// *rsa.PrivateKey, *ecdsa.PrivateKey, *btcec.PrivateKey or ed25519.PrivateKey
+func PKIXPublicKeyPEM(privKey interface{}) string {
+	var der []byte
+	var err error
+	switch key := privKey.(type) {
+	case *rsa.PrivateKey:
+		return PublicKeyPEM(key)
+	case *ecdsa.PrivateKey:
+		der, err = x509.MarshalPKIXPublicKey(&key.PublicKey)
+	case *btcec.PrivateKey:
+		var namedCurve []byte
+		namedCurve, err = asn1.Marshal(asn1.ObjectIdentifier{1, 3, 132, 0, 10})
+		if err != nil {
+			panic(err)
+		}
+		der, err = marshalPKIXPublicKey(asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}, namedCurve, key.PubKey().SerializeUncompressed())
+	case ed25519.PrivateKey:
+		der, err = marshalPKIXPublicKey(asn1.ObjectIdentifier{1, 3, 101, 112}, nil, key.Public().(ed25519.PublicKey))
+	default:
+		panic("unsupported key type")
+	}
+	if err != nil {
+		panic(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func marshalPKIXPublicKey(algorithm asn1.ObjectIdentifier, parameters []byte, publicKey []byte) ([]byte, error) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	spki.Algorithm.Algorithm = algorithm
+	if parameters != nil {
+		spki.Algorithm.Parameters.FullBytes = parameters
+	}
+	spki.PublicKey = asn1.BitString{Bytes: publicKey, BitLength: 8 * len(publicKey)}
+	return asn1.Marshal(spki)
+}
+
+// NewSchemeTx returns Tx signed with privKey (see PKIXPublicKeyPEM for key
+// types) using signatureScheme. PSS schemes sign with RSA PSS padding and
+// PSS_SHA256 signs data without base64 encoding.
+func NewSchemeTx(method string, param interface{}, nodeID string, privKey interface{}, signatureScheme protoTm.SignatureScheme) []byte {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		panic(err)
+	}
+	nonce := []byte(base64.StdEncoding.EncodeToString([]byte(common.RandStr(12))))
+	message := append([]byte(method), paramJSON...)
+	message = append(message, nonce...)
+	if signatureScheme != protoTm.SignatureScheme_PSS_SHA256 {
+		message = []byte(base64.StdEncoding.EncodeToString(message))
+	}
+	hash := crypto.SHA256.New()
+	hash.Write(message)
+	hashed := hash.Sum(nil)
+	var signature []byte
+	switch key := privKey.(type) {
+	case *rsa.PrivateKey:
+		if signatureScheme == protoTm.SignatureScheme_PKCS1V15_SHA256_BASE64 {
+			signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed)
+		} else {
+			signature, err = rsa.SignPSS(rand.Reader, key, crypto.SHA256, hashed, nil)
+		}
+	case *ecdsa.PrivateKey:
+		signature, err = key.Sign(rand.Reader, hashed, crypto.SHA256)
+	case *btcec.PrivateKey:
+		var sig *btcec.Signature
+		sig, err = key.Sign(hashed)
+		if err == nil {
+			signature = sig.Serialize()
+		}
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(key, message)
+	default:
+		panic("unsupported key type")
+	}
+	if err != nil {
+		panic(err)
+	}
+	var tx protoTm.Tx
+	tx.Method = method
+	tx.Params = string(paramJSON)
+	tx.Nonce = nonce
+	tx.Signature = signature
+	tx.NodeId = nodeID
+	tx.SignatureScheme = signatureScheme
+	txBytes, err := proto.Marshal(&tx)
+	if err != nil {
+		panic(err)
+	}
+	return txBytes
+}
+
+// NewTx returns signed Tx (PKCS#1 v1.5 signature scheme)
+func NewTx(method string, param interface{}, nodeID string, privKey *rsa.PrivateKey, validUntilBlock int64) []byte {
+	return NewDelegateTx(method, param, nodeID, "", privKey, validUntilBlock)
+}
+
+// NewDelegateTx returns Tx signed with delegate key of keyID
+// (node key if keyID is empty)
+func NewDelegateTx(method string, param interface{}, nodeID string, keyID string, privKey *rsa.PrivateKey, validUntilBlock int64) []byte {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		panic(err)
+	}
+	nonce := []byte(base64.StdEncoding.EncodeToString([]byte(common.RandStr(12))))
+	message := append([]byte(method), paramJSON...)
+	message = append(message, nonce...)
+	if validUntilBlock != 0 {
+		message = append([]byte(strconv.FormatInt(validUntilBlock, 10)+"|"), message...)
+	}
+	hash := crypto.SHA256.New()
+	hash.Write([]byte(base64.StdEncoding.EncodeToString(message)))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privKey, crypto.SHA256, hash.Sum(nil))
+	if err != nil {
+		panic(err)
+	}
+	var tx protoTm.Tx
+	tx.Method = method
+	tx.Params = string(paramJSON)
+	tx.Nonce = nonce
+	tx.Signature = signature
+	tx.NodeId = nodeID
+	tx.ValidUntilBlock = validUntilBlock
+	tx.KeyId = keyID
+	txBytes, err := proto.Marshal(&tx)
+	if err != nil {
+		panic(err)
+	}
+	return txBytes
+}
+
+// NewTypedTx returns Tx with typed params signed in serialized form
+// (PKCS#1 v1.5 signature scheme)
+func NewTypedTx(method string, typedParams *protoParams.TxParams, nodeID string, privKey *rsa.PrivateKey) []byte {
+	typedParamsBytes, err := proto.Marshal(typedParams)
+	if err != nil {
+		panic(err)
+	}
+	nonce := []byte(base64.StdEncoding.EncodeToString([]byte(common.RandStr(12))))
+	message := append([]byte(method), typedParamsBytes...)
+	message = append(message, nonce...)
+	hash := crypto.SHA256.New()
+	hash.Write([]byte(base64.StdEncoding.EncodeToString(message)))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privKey, crypto.SHA256, hash.Sum(nil))
+	if err != nil {
+		panic(err)
+	}
+	var tx protoTm.Tx
+	tx.Method = method
+	tx.TypedParams = typedParamsBytes
+	tx.Nonce = nonce
+	tx.Signature = signature
+	tx.NodeId = nodeID
+	txBytes, err := proto.Marshal(&tx)
+	if err != nil {
+		panic(err)
+	}
+	return txBytes
+}
+
+// NewBatchOperation returns operation of Batch Tx with JSON params
+func NewBatchOperation(method string, param interface{}) app.BatchOperation {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		panic(err)
+	}
+	return app.BatchOperation{Method: method, Params: string(paramJSON)}
+}
+
+// Block runs a block with txs (each tx is checked before the block)
+// and returns CheckTx and DeliverTx responses
+func (testApp *App) Block(txs ...[]byte) ([]types.ResponseCheckTx, []types.ResponseDeliverTx) {
+	checkTxResults := make([]types.ResponseCheckTx, 0, len(txs))
+	for _, tx := range txs {
+		checkTxResults = append(checkTxResults, testApp.App.CheckTx(types.RequestCheckTx{Tx: tx}))
+	}
+	testApp.Height++
+	testApp.BlockTime = testApp.BlockTime.Add(time.Second)
+	testApp.BeginBlockEvents = testApp.App.BeginBlock(types.RequestBeginBlock{
+		Header: types.Header{ChainID: ChainID, Height: testApp.Height, Time: testApp.BlockTime},
+	}).Events
+	deliverTxResults := make([]types.ResponseDeliverTx, 0, len(txs))
+	for _, tx := range txs {
+		deliverTxResults = append(deliverTxResults, testApp.App.DeliverTx(types.RequestDeliverTx{Tx: tx}))
+	}
+	testApp.App.EndBlock(types.RequestEndBlock{Height: testApp.Height})
+	testApp.App.Commit()
+	return checkTxResults, deliverTxResults
+}
+
+// EmptyBlocks runs count blocks without Tx
+func (testApp *App) EmptyBlocks(count int) {
+	for i := 0; i < count; i++ {
+		testApp.Block()
+	}
+}
+
+// DeliverTx includes tx in a new block without CheckTx and returns DeliverTx response
+func (testApp *App) DeliverTx(tx []byte) types.ResponseDeliverTx {
+	testApp.Height++
+	testApp.BlockTime = testApp.BlockTime.Add(time.Second)
+	testApp.BeginBlockEvents = testApp.App.BeginBlock(types.RequestBeginBlock{
+		Header: types.Header{ChainID: ChainID, Height: testApp.Height, Time: testApp.BlockTime},
+	}).Events
+	result := testApp.App.DeliverTx(types.RequestDeliverTx{Tx: tx})
+	testApp.App.EndBlock(types.RequestEndBlock{Height: testApp.Height})
+	testApp.App.Commit()
+	return result
+}
+
+// Deliver signs Tx, checks it and includes it in a new block.
+// Returns DeliverTx response or CheckTx response if CheckTx fails.
+func (testApp *App) Deliver(method string, param interface{}, nodeID string, privKey *rsa.PrivateKey) (uint32, string) {
+	return testApp.CheckAndDeliverTx(NewTx(method, param, nodeID, privKey, 0))
+}
+
+// CheckAndDeliverTx checks tx and includes it in a new block.
+// Returns DeliverTx response or CheckTx response if CheckTx fails.
+func (testApp *App) CheckAndDeliverTx(tx []byte) (uint32, string) {
+	checkTxResult := testApp.App.CheckTx(types.RequestCheckTx{Tx: tx})
+	if checkTxResult.Code != code.OK {
+		// Clear nonce of rejected Tx from CheckTx state
+		testApp.EmptyBlocks(1)
+		return checkTxResult.Code, checkTxResult.Log
+	}
+	result := testApp.DeliverTx(tx)
+	return result.Code, result.Log
+}
+
+func (testApp *App) MustDeliver(method string, param interface{}, nodeID string, privKey *rsa.PrivateKey) {
+	testApp.T.Helper()
+	testApp.ExpectDeliver(method, param, nodeID, privKey, code.OK)
+}
+
+func (testApp *App) ExpectDeliver(method string, param interface{}, nodeID string, privKey *rsa.PrivateKey, expectedCode uint32) {
+	testApp.T.Helper()
+	resultCode, resultLog := testApp.Deliver(method, param, nodeID, privKey)
+	if resultCode != expectedCode {
+		testApp.T.Fatalf("FAIL: %s\nExpected code: %d\nActual: %d (%s)", method, expectedCode, resultCode, resultLog)
+	}
+}
+
+// Query returns ABCI query response at latest height
+func (testApp *App) Query(method string, param interface{}, prove bool) types.ResponseQuery {
+	return testApp.QueryAt(method, param, 0, prove)
+}
+
+// QueryAt returns ABCI query response at height (0 means latest height)
+func (testApp *App) QueryAt(method string, param interface{}, height int64, prove bool) types.ResponseQuery {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		panic(err)
+	}
+	var query protoTm.Query
+	query.Method = method
+	query.Params = string(paramJSON)
+	queryBytes, err := proto.Marshal(&query)
+	if err != nil {
+		panic(err)
+	}
+	return testApp.App.Query(types.RequestQuery{Data: queryBytes, Height: height, Prove: prove})
+}
+
+// QueryResult unmarshals JSON value of query response to result
+func (testApp *App) QueryResult(method string, param interface{}, result interface{}) {
+	testApp.T.Helper()
+	res := testApp.Query(method, param, false)
+	err := json.Unmarshal(res.Value, result)
+	if err != nil {
+		testApp.T.Fatalf("FAIL: %s\nCannot unmarshal result %q (%s): %s", method, string(res.Value), res.Log, err.Error())
+	}
+}
+
+// TypedQuery returns ABCI query response of query with typed params at latest height
+func (testApp *App) TypedQuery(method string, typedParams *protoParams.QueryParams) types.ResponseQuery {
+	typedParamsBytes, err := proto.Marshal(typedParams)
+	if err != nil {
+		panic(err)
+	}
+	var query protoTm.Query
+	query.Method = method
+	query.TypedParams = typedParamsBytes
+	queryBytes, err := proto.Marshal(&query)
+	if err != nil {
+		panic(err)
+	}
+	return testApp.App.Query(types.RequestQuery{Data: queryBytes})
+}
+
+// ExpectToken checks token amount of node
+func (testApp *App) ExpectToken(nodeID string, expected app.DecimalAmount) {
+	testApp.T.Helper()
+	var token app.GetNodeTokenResult
+	testApp.QueryResult("GetNodeToken", app.GetNodeTokenParam{NodeID: nodeID}, &token)
+	if token.Amount != expected {
+		testApp.T.Fatalf("FAIL: Token of %s\nExpected: %s\nActual: %s", nodeID, expected, token.Amount)
+	}
+}
+
+// KeyVersions returns block heights of versions of versioned state key
+func (testApp *App) KeyVersions(key string) []int64 {
+	testApp.T.Helper()
+	res := testApp.App.Query(types.RequestQuery{Path: "/key", Data: []byte(key + "|versions")})
+	var keyVersions protoData.KeyVersions
+	err := proto.Unmarshal(res.Value, &keyVersions)
+	if err != nil {
+		testApp.T.Fatalf("FAIL: cannot unmarshal versions of %s: %s", key, err.Error())
+	}
+	return keyVersions.Versions
+}
+
+// ExpectBeginBlockEvent fails test if BeginBlock of the latest block has no
+// event of eventType with attribute key set to value
+func (testApp *App) ExpectBeginBlockEvent(eventType string, key string, value string) {
+	testApp.T.Helper()
+	if !HasEvent(testApp.BeginBlockEvents, eventType, key, value) {
+		testApp.T.Fatalf("FAIL: no %s event with %s %s in BeginBlock of block %d", eventType, key, value, testApp.Height)
+	}
+}
+
+// HasEvent reports whether events has an event of eventType with attribute
+// key set to value
+func HasEvent(events []types.Event, eventType string, key string, value string) bool {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == key && string(attribute.Value) == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// StateValue returns raw value of state key in committed state
+func (testApp *App) StateValue(key []byte) []byte {
+	return testApp.App.Query(types.RequestQuery{Path: "/key", Data: key}).Value
+}
+
+// AppHash returns app hash of latest committed state
+func (testApp *App) AppHash() []byte {
+	return testApp.App.Info(types.RequestInfo{}).LastBlockAppHash
+}
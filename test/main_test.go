@@ -187,3 +187,49 @@ func TestIdP1RevokeAndAddAccessor(t *testing.T) {
 	query.TestGetAccessorKey(t, data.AccessorID5.String(), `{"accessor_public_key":"`+strings.Replace(data.AccessorPubKey2, "\n", "\\n", -1)+`","active":true}`)
 
 }
+
+// Tests below run the ABCI app in process (see test/local) and do not need a running node.
+
+func TestLocalNDID(t *testing.T) {
+	t.Run("NodeCreditLimit", ndid.TestNodeCreditLimit)
+	t.Run("FeePolicy", ndid.TestFeePolicy)
+	t.Run("FeeRequestIDUnmarshalError", ndid.TestFeeRequestIDUnmarshalError)
+	t.Run("GovernanceApprovalThreshold", ndid.TestGovernanceApprovalThreshold)
+	t.Run("PriceFuncSchedulePruning", ndid.TestPriceFuncSchedulePruning)
+	t.Run("MinimumSignatureScheme", ndid.TestMinimumSignatureScheme)
+	t.Run("TokenTransferPolicy", ndid.TestTokenTransferPolicy)
+	t.Run("VersionPruningSweep", ndid.TestVersionPruningSweep)
+}
+
+func TestLocalIdP(t *testing.T) {
+	t.Run("SetIdentityActive", idp.TestSetIdentityActive)
+	t.Run("UpdateAndWithdrawIdpResponse", idp.TestUpdateAndWithdrawIdpResponse)
+	t.Run("MergeReferenceGroup", idp.TestMergeReferenceGroup)
+	t.Run("PendingRegisterIdentity", idp.TestPendingRegisterIdentity)
+}
+
+func TestLocalCommon(t *testing.T) {
+	t.Run("BatchRollback", common.TestBatchRollback)
+	t.Run("BatchFeeOnFailure", common.TestBatchFeeOnFailure)
+	t.Run("DelegateKeyScope", common.TestDelegateKeyScope)
+	t.Run("NodeKeyTypes", common.TestNodeKeyTypes)
+	t.Run("NodeKeyRotationGraceWindow", common.TestNodeKeyRotationGraceWindow)
+	t.Run("NonceReplayAcrossUpgrade", common.TestNonceReplayAcrossUpgrade)
+	t.Run("LegacyNonceMigration", common.TestLegacyNonceMigration)
+	t.Run("NonceDefaultExpiry", common.TestNonceDefaultExpiry)
+	t.Run("RequestTimeoutByBlockTime", common.TestRequestTimeoutByBlockTime)
+	t.Run("RequestTimeoutIndexBackfill", common.TestRequestTimeoutIndexBackfill)
+	t.Run("RequestSettlement", common.TestRequestSettlement)
+	t.Run("StateSnapshot", common.TestStateSnapshot)
+	t.Run("TypedTokenAmount", common.TestTypedTokenAmount)
+	t.Run("TypedParamsUnknownField", common.TestTypedParamsUnknownField)
+	t.Run("TypedAssuranceLevels", common.TestTypedAssuranceLevels)
+}
+
+func TestLocalQuery(t *testing.T) {
+	t.Run("QueryProof", query.TestQueryProof)
+	t.Run("QueryProofAfterUpgrade", query.TestQueryProofAfterUpgrade)
+	t.Run("TokenStatementProofAndHeight", query.TestTokenStatementProofAndHeight)
+	t.Run("UsageReport", query.TestUsageReport)
+	t.Run("UsageReportProofAndHeight", query.TestUsageReportProofAndHeight)
+}
@@ -0,0 +1,151 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package ndid
+
+import (
+	"testing"
+
+	"github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestNodeCreditLimit(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	deliver := func(method string, param interface{}, nodeID string, expectedCode uint32) types.ResponseDeliverTx {
+		t.Helper()
+		privKey := local.IdP1PrivKey
+		if nodeID == local.NDID {
+			privKey = local.NDIDPrivKey
+		}
+		result := testApp.DeliverTx(local.NewTx(method, param, nodeID, privKey, 0))
+		if result.Code != expectedCode {
+			t.Fatalf("FAIL: %s\nExpected code: %d\nActual: %d (%s)", method, expectedCode, result.Code, result.Log)
+		}
+		return result
+	}
+	// Each Tx of IdP1 is charged fee 1
+	spend := func(expectedCode uint32) types.ResponseDeliverTx {
+		t.Helper()
+		return deliver("SetMqAddresses", app.SetMqAddressesParam{
+			Addresses: []app.MsqAddress{{IP: "127.0.0.1", Port: 8000}},
+		}, local.IdP1, expectedCode)
+	}
+	setCreditLimit := func(creditLimit, lowBalanceThreshold app.DecimalAmount, expectedCode uint32) types.ResponseDeliverTx {
+		t.Helper()
+		return deliver("SetNodeCreditLimit", app.SetNodeCreditLimitParam{
+			NodeID:              local.IdP1,
+			CreditLimit:         creditLimit,
+			LowBalanceThreshold: lowBalanceThreshold,
+		}, local.NDID, expectedCode)
+	}
+	expectEvent := func(result types.ResponseDeliverTx, eventType string, key string, value string) {
+		t.Helper()
+		if !local.HasEvent(result.Events, eventType, key, value) {
+			t.Fatalf("FAIL: no %s event with %s %s: %+v", eventType, key, value, result.Events)
+		}
+	}
+	expectNodes := func(status string, expected ...string) {
+		t.Helper()
+		var result app.GetNodesByBalanceStatusResult
+		testApp.QueryResult("GetNodesByBalanceStatus", app.GetNodesByBalanceStatusParam{Status: status}, &result)
+		nodeIDs := make([]string, 0, len(result.Nodes))
+		for _, node := range result.Nodes {
+			if node.Status != status {
+				t.Fatalf("FAIL: %s has status %s in %s nodes", node.NodeID, node.Status, status)
+			}
+			nodeIDs = append(nodeIDs, node.NodeID)
+		}
+		if len(nodeIDs) != len(expected) {
+			t.Fatalf("FAIL: %s nodes\nExpected: %v\nActual: %v", status, expected, nodeIDs)
+		}
+		for i := range expected {
+			if nodeIDs[i] != expected[i] {
+				t.Fatalf("FAIL: %s nodes\nExpected: %v\nActual: %v", status, expected, nodeIDs)
+			}
+		}
+	}
+
+	setCreditLimit("-1", "0", code.CreditLimitMustBeGreaterOrEqualToZero)
+	setCreditLimit("10", "-1", code.LowBalanceThresholdMustBeGreaterOrEqualToZero)
+
+	setCreditLimit("10", "50", code.OK)
+	deliver("SetNodeToken", app.SetNodeTokenParam{NodeID: local.IdP1, Amount: "51"}, local.NDID, code.OK)
+	result := spend(code.OK)
+	if local.HasEvent(result.Events, "did.token_low_balance", "node_id", local.IdP1) {
+		t.Fatalf("FAIL: low balance event when balance is not below threshold")
+	}
+	result = spend(code.OK)
+	expectEvent(result, "did.token_low_balance", "balance", "49")
+	expectEvent(result, "did.token_low_balance", "low_balance_threshold", "50")
+	expectNodes("low_balance", local.IdP1)
+
+	// Balance can be negative down to credit limit
+	deliver("SetNodeToken", app.SetNodeTokenParam{NodeID: local.IdP1, Amount: "0.5"}, local.NDID, code.OK)
+	spend(code.OK)
+	testApp.ExpectToken(local.IdP1, "-0.5")
+
+	// Debit below credit limit is rejected (escrow of 2 responses is 20)
+	deliver("SetIdpResponseFee", app.SetIdpResponseFeeParam{Fee: "10"}, local.NDID, code.OK)
+	result = deliver("CreateRequest", app.CreateRequestParam{
+		RequestID:       "request_1",
+		MinIdp:          2,
+		MinAal:          1,
+		MinIal:          1,
+		Timeout:         1000,
+		IdPIDList:       []string{local.IdP2},
+		DataRequestList: []app.DataRequest{},
+		MessageHash:     "hash",
+		Mode:            1,
+	}, local.IdP1, code.TokenNotEnough)
+	expectEvent(result, "did.token_over_limit", "amount", "20")
+	expectEvent(result, "did.token_over_limit", "credit_limit", "10")
+	testApp.ExpectToken(local.IdP1, "-1.5")
+
+	// Balance is over limit after credit limit is lowered
+	result = setCreditLimit("1", "50", code.OK)
+	expectEvent(result, "did.token_over_limit", "node_id", local.IdP1)
+	expectEvent(result, "did.token_over_limit", "amount", "0")
+	expectNodes("over_limit", local.IdP1)
+	expectNodes("low_balance")
+	var token app.GetNodeTokenResult
+	testApp.QueryResult("GetNodeToken", app.GetNodeTokenParam{NodeID: local.IdP1}, &token)
+	if token.Status != "over_limit" || token.CreditLimit != "1" || token.LowBalanceThreshold != "50" {
+		t.Fatalf("FAIL: token of %s: %+v", local.IdP1, token)
+	}
+
+	var allNodes app.GetNodesByBalanceStatusResult
+	testApp.QueryResult("GetNodesByBalanceStatus", app.GetNodesByBalanceStatusParam{}, &allNodes)
+	for _, node := range allNodes.Nodes {
+		if node.NodeID != local.IdP1 && node.Status != "normal" {
+			t.Fatalf("FAIL: %s has status %s", node.NodeID, node.Status)
+		}
+	}
+	res := testApp.Query("GetNodesByBalanceStatus", app.GetNodesByBalanceStatusParam{Status: "unknown"}, false)
+	if res.Value != nil || res.Log != "Invalid balance status" {
+		t.Fatalf("FAIL: invalid balance status: %q (%s)", string(res.Value), res.Log)
+	}
+	t.Logf("PASS: node credit limit")
+}
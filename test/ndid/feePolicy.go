@@ -0,0 +1,100 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package ndid
+
+import (
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestFeePolicy(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	setFeePolicy := func(mode string, failureFee app.DecimalAmount) {
+		t.Helper()
+		testApp.MustDeliver("SetFeePolicy", app.SetFeePolicyParam{
+			Method:     "TransferToken",
+			Mode:       mode,
+			FailureFee: failureFee,
+		}, local.NDID, local.NDIDPrivKey)
+	}
+	transfer := func(amount app.DecimalAmount, expectedCode uint32) {
+		t.Helper()
+		testApp.ExpectDeliver("TransferToken", app.TransferTokenParam{ToNodeID: local.IdP2, Amount: amount}, local.IdP1, local.IdP1PrivKey, expectedCode)
+	}
+	testApp.MustDeliver("SetTokenTransferPolicy", app.SetTokenTransferPolicyParam{Enabled: false}, local.NDID, local.NDIDPrivKey)
+
+	// Fee is charged whether or not Tx succeeds by default (price is 1)
+	transfer("10", code.OK)
+	testApp.ExpectToken(local.IdP1, "89")
+	testApp.ExpectToken(local.IdP2, "110")
+	transfer("1000", code.TokenNotEnough)
+	testApp.ExpectToken(local.IdP1, "88")
+
+	// Transfer which leaves no token for fee is rolled back and charged as failed Tx
+	transfer("88", code.TokenNotEnough)
+	testApp.ExpectToken(local.IdP1, "87")
+	testApp.ExpectToken(local.IdP2, "110")
+
+	setFeePolicy("success_only", "")
+	transfer("87", code.TokenNotEnough)
+	testApp.ExpectToken(local.IdP1, "87")
+	testApp.ExpectToken(local.IdP2, "110")
+
+	setFeePolicy("reduced_on_failure", "0.5")
+	transfer("1000", code.TokenNotEnough)
+	testApp.ExpectToken(local.IdP1, "86.5")
+	transfer("1", code.OK)
+	testApp.ExpectToken(local.IdP1, "84.5")
+
+	setFeePolicy("waive", "")
+	transfer("84.5", code.OK)
+	testApp.ExpectToken(local.IdP1, "0")
+	testApp.ExpectToken(local.IdP2, "195.5")
+}
+
+func TestFeeRequestIDUnmarshalError(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	testApp.MustDeliver("SetFeePolicy", app.SetFeePolicyParam{
+		Method: "SetMqAddresses",
+		Mode:   "reduced_on_failure",
+		// Failure fee is 0.5 of fee 1
+		FailureFee: "0.5",
+	}, local.NDID, local.NDIDPrivKey)
+
+	// Request ID of fee record cannot be read so Tx fails and is charged as
+	// a failed Tx
+	testApp.ExpectDeliver("SetMqAddresses", map[string]interface{}{
+		"addresses":  []app.MsqAddress{{IP: "127.0.0.1", Port: 8000}},
+		"request_id": 1,
+	}, local.IdP1, local.IdP1PrivKey, code.UnmarshalError)
+	testApp.ExpectToken(local.IdP1, "99.5")
+	var mqAddresses []app.MsqAddress
+	testApp.QueryResult("GetMqAddresses", app.GetMqAddressesParam{NodeID: local.IdP1}, &mqAddresses)
+	if len(mqAddresses) != 0 {
+		t.Fatalf("FAIL: changes of failed Tx are not rolled back: %+v", mqAddresses)
+	}
+	t.Logf("PASS: fee request ID unmarshal error")
+}
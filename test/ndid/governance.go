@@ -0,0 +1,121 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package ndid
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+// signNDIDProposal returns approval signature of governance key
+func signNDIDProposal(proposal app.GetNDIDProposalResult, chainID string, privKey *rsa.PrivateKey) []byte {
+	message := strconv.FormatInt(proposal.DeadlineBlock, 10) + "|" + proposal.Method + proposal.Params + proposal.ProposalID + "|" + chainID
+	hash := crypto.SHA256.New()
+	hash.Write([]byte(message))
+	signature, err := rsa.SignPSS(rand.Reader, privKey, crypto.SHA256, hash.Sum(nil), nil)
+	if err != nil {
+		panic(err)
+	}
+	return signature
+}
+
+func TestGovernanceApprovalThreshold(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	governanceKeys := map[string]*rsa.PrivateKey{
+		"governance_1": local.IdP1PrivKey,
+		"governance_2": local.IdP2PrivKey,
+		"governance_3": local.AS1PrivKey,
+	}
+	var setGovernanceParam app.SetGovernanceParam
+	for _, keyID := range []string{"governance_1", "governance_2", "governance_3"} {
+		setGovernanceParam.Keys = append(setGovernanceParam.Keys, app.GovernanceKey{
+			KeyID:     keyID,
+			PublicKey: local.PublicKeyPEM(governanceKeys[keyID]),
+		})
+	}
+	setGovernanceParam.Threshold = 2
+	setGovernanceParam.ProposalTimeoutBlock = 100
+	testApp.MustDeliver("SetGovernance", setGovernanceParam, local.NDID, local.NDIDPrivKey)
+
+	namespace := app.Namespace{
+		Namespace:   "citizenId",
+		Description: "Citizen ID",
+	}
+	testApp.ExpectDeliver("AddNamespace", namespace, local.NDID, local.NDIDPrivKey, code.NDIDMethodMustBeProposedToGovernance)
+
+	namespaceJSON, _ := json.Marshal(namespace)
+	testApp.MustDeliver("CreateNDIDProposal", app.CreateNDIDProposalParam{
+		ProposalID: "proposal_1",
+		Method:     "AddNamespace",
+		Params:     string(namespaceJSON),
+	}, local.NDID, local.NDIDPrivKey)
+	var proposal app.GetNDIDProposalResult
+	testApp.QueryResult("GetNDIDProposal", app.GetNDIDProposalParam{ProposalID: "proposal_1"}, &proposal)
+
+	approve := func(keyID string, signature []byte, expectedCode uint32) {
+		t.Helper()
+		testApp.ExpectDeliver("ApproveNDIDProposal", app.ApproveNDIDProposalParam{
+			ProposalID: proposal.ProposalID,
+			KeyID:      keyID,
+			Signature:  signature,
+		}, local.NDID, local.NDIDPrivKey, expectedCode)
+	}
+	expectStatus := func(status string) {
+		t.Helper()
+		var result app.GetNDIDProposalResult
+		testApp.QueryResult("GetNDIDProposal", app.GetNDIDProposalParam{ProposalID: "proposal_1"}, &result)
+		if result.Status != status {
+			t.Fatalf("FAIL: proposal status\nExpected: %s\nActual: %s", status, result.Status)
+		}
+	}
+
+	approve("governance_1", signNDIDProposal(proposal, local.ChainID, governanceKeys["governance_1"]), code.OK)
+	expectStatus("pending")
+	approve("governance_1", signNDIDProposal(proposal, local.ChainID, governanceKeys["governance_1"]), code.DuplicateNDIDProposalApproval)
+	// Approval signed for another chain or by another key does not count
+	approve("governance_2", signNDIDProposal(proposal, "other-chain", governanceKeys["governance_2"]), code.VerifySignatureError)
+	approve("governance_2", signNDIDProposal(proposal, local.ChainID, governanceKeys["governance_3"]), code.VerifySignatureError)
+	expectStatus("pending")
+	var namespaces []app.Namespace
+	testApp.QueryResult("GetNamespaceList", struct{}{}, &namespaces)
+	if len(namespaces) != 0 {
+		t.Fatalf("FAIL: namespace is added before threshold is reached: %v", namespaces)
+	}
+
+	approve("governance_2", signNDIDProposal(proposal, local.ChainID, governanceKeys["governance_2"]), code.OK)
+	expectStatus("executed")
+	testApp.QueryResult("GetNamespaceList", struct{}{}, &namespaces)
+	if len(namespaces) != 1 || namespaces[0].Namespace != namespace.Namespace {
+		t.Fatalf("FAIL: namespace is not added by executed proposal: %v", namespaces)
+	}
+	approve("governance_3", signNDIDProposal(proposal, local.ChainID, governanceKeys["governance_3"]), code.NDIDProposalIsNotPending)
+	t.Logf("PASS: governance approval threshold")
+}
@@ -315,7 +315,7 @@ func TestRegisterNode(t *testing.T, nodeID string) {
 func TestSetNodeToken(t *testing.T, nodeID string, amount float64) {
 	var param app.SetNodeTokenParam
 	param.NodeID = nodeID
-	param.Amount = amount
+	param.Amount = app.DecimalAmount(fmt.Sprint(amount))
 	SetNodeToken(t, ndidNodeID, data.NdidPrivK, param)
 }
 
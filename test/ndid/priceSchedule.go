@@ -0,0 +1,78 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package ndid
+
+import (
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestPriceFuncSchedulePruning(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	setPrice := func(param app.SetPriceFuncParam) {
+		t.Helper()
+		param.Func = "SetMqAddresses"
+		testApp.MustDeliver("SetPriceFunc", param, local.NDID, local.NDIDPrivKey)
+	}
+	expectSchedule := func(expected ...app.PriceFuncScheduleEntry) {
+		t.Helper()
+		var schedule app.GetPriceFuncScheduleResult
+		testApp.QueryResult("GetPriceFuncSchedule", app.GetPriceFuncScheduleParam{Func: "SetMqAddresses"}, &schedule)
+		if len(schedule.Entries) != len(expected) {
+			t.Fatalf("FAIL: Price schedule\nExpected: %+v\nActual: %+v", expected, schedule.Entries)
+		}
+		for i := range expected {
+			if schedule.Entries[i] != expected[i] {
+				t.Fatalf("FAIL: Price schedule entry %d\nExpected: %+v\nActual: %+v", i, expected[i], schedule.Entries[i])
+			}
+		}
+	}
+
+	futureHeight := testApp.Height + 100
+	setPrice(app.SetPriceFuncParam{Price: "3", EffectiveBlockHeight: futureHeight})
+	setPrice(app.SetPriceFuncParam{Price: "2", Role: "IdP"})
+	idpPriceHeight := testApp.Height
+	setPrice(app.SetPriceFuncParam{Price: "1"})
+	setPrice(app.SetPriceFuncParam{Price: "0.5"})
+	defaultPriceHeight := testApp.Height
+	// Superseded price for every node is removed
+	expectSchedule(
+		app.PriceFuncScheduleEntry{EffectiveBlockHeight: idpPriceHeight, Role: "IdP", Price: "2"},
+		app.PriceFuncScheduleEntry{EffectiveBlockHeight: defaultPriceHeight, Price: "0.5"},
+		app.PriceFuncScheduleEntry{EffectiveBlockHeight: futureHeight, Price: "3"},
+	)
+
+	// Override is removed together with its removal
+	setPrice(app.SetPriceFuncParam{Role: "IdP", RemoveOverride: true})
+	expectSchedule(
+		app.PriceFuncScheduleEntry{EffectiveBlockHeight: defaultPriceHeight, Price: "0.5"},
+		app.PriceFuncScheduleEntry{EffectiveBlockHeight: futureHeight, Price: "3"},
+	)
+	var price app.GetPriceFuncResult
+	testApp.QueryResult("GetPriceFunc", app.GetPriceFuncParam{Func: "SetMqAddresses", NodeID: local.IdP1}, &price)
+	if price.Price != "0.5" {
+		t.Fatalf("FAIL: Price of %s\nExpected: 0.5\nActual: %s", local.IdP1, price.Price)
+	}
+}
@@ -0,0 +1,94 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package ndid
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	protoTm "github.com/ndidplatform/smart-contract/v4/protos/tendermint"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestMinimumSignatureScheme(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	setMqAddressesParam := app.SetMqAddressesParam{
+		Addresses: []app.MsqAddress{{IP: "127.0.0.1", Port: 8000}},
+	}
+	expectTx := func(tx []byte, expectedCode uint32, name string) {
+		t.Helper()
+		resultCode, resultLog := testApp.CheckAndDeliverTx(tx)
+		if resultCode != expectedCode {
+			t.Fatalf("FAIL: %s\nExpected code: %d\nActual: %d (%s)", name, expectedCode, resultCode, resultLog)
+		}
+	}
+	// withScheme returns tx with signature scheme field replaced
+	withScheme := func(tx []byte, signatureScheme protoTm.SignatureScheme) []byte {
+		var txObj protoTm.Tx
+		if err := proto.Unmarshal(tx, &txObj); err != nil {
+			t.Fatal(err)
+		}
+		txObj.SignatureScheme = signatureScheme
+		txBytes, err := proto.Marshal(&txObj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return txBytes
+	}
+
+	// PKCS#1 v1.5 signature does not verify as PSS signature
+	newPKCS1Tx := func() []byte {
+		return local.NewSchemeTx("SetMqAddresses", setMqAddressesParam, local.IdP1, local.IdP1PrivKey, protoTm.SignatureScheme_PKCS1V15_SHA256_BASE64)
+	}
+	expectTx(withScheme(newPKCS1Tx(), protoTm.SignatureScheme_PSS_SHA256_BASE64), code.VerifySignatureError, "PKCS#1 v1.5 signature with PSS scheme")
+	expectTx(withScheme(newPKCS1Tx(), protoTm.SignatureScheme(7)), code.InvalidSignatureScheme, "unknown signature scheme")
+
+	testApp.ExpectDeliver("SetMinimumSignatureScheme", app.SetMinimumSignatureSchemeParam{
+		SignatureScheme: "PSS_SHA512",
+	}, local.NDID, local.NDIDPrivKey, code.InvalidSignatureScheme)
+	testApp.MustDeliver("SetMinimumSignatureScheme", app.SetMinimumSignatureSchemeParam{
+		SignatureScheme: "PSS_SHA256_BASE64",
+	}, local.NDID, local.NDIDPrivKey)
+	var minimumSignatureScheme app.GetMinimumSignatureSchemeResult
+	testApp.QueryResult("GetMinimumSignatureScheme", nil, &minimumSignatureScheme)
+	if minimumSignatureScheme.SignatureScheme != "PSS_SHA256_BASE64" {
+		t.Fatalf("FAIL: GetMinimumSignatureScheme\nExpected: PSS_SHA256_BASE64\nActual: %s", minimumSignatureScheme.SignatureScheme)
+	}
+
+	// Scheme lower than minimum is rejected, including NDID's Tx
+	expectTx(newPKCS1Tx(), code.SignatureSchemeIsLowerThanMinimum, "PKCS#1 v1.5 scheme below minimum")
+	testApp.ExpectDeliver("SetMinimumSignatureScheme", app.SetMinimumSignatureSchemeParam{
+		SignatureScheme: "PKCS1V15_SHA256_BASE64",
+	}, local.NDID, local.NDIDPrivKey, code.SignatureSchemeIsLowerThanMinimum)
+	expectTx(local.NewSchemeTx("SetMqAddresses", setMqAddressesParam, local.IdP1, local.IdP1PrivKey, protoTm.SignatureScheme_PSS_SHA256_BASE64), code.OK, "PSS scheme at minimum")
+	expectTx(local.NewSchemeTx("SetMqAddresses", setMqAddressesParam, local.IdP1, local.IdP1PrivKey, protoTm.SignatureScheme_PSS_SHA256), code.OK, "PSS scheme above minimum")
+
+	// NDID lowers minimum with Tx of accepted scheme
+	expectTx(local.NewSchemeTx("SetMinimumSignatureScheme", app.SetMinimumSignatureSchemeParam{
+		SignatureScheme: "PKCS1V15_SHA256_BASE64",
+	}, local.NDID, local.NDIDPrivKey, protoTm.SignatureScheme_PSS_SHA256), code.OK, "SetMinimumSignatureScheme with PSS scheme")
+	testApp.MustDeliver("SetMqAddresses", setMqAddressesParam, local.IdP1, local.IdP1PrivKey)
+	t.Logf("PASS: SetMinimumSignatureScheme")
+}
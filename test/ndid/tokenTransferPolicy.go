@@ -0,0 +1,51 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package ndid
+
+import (
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/abci/code"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestTokenTransferPolicy(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	transferParam := app.TransferTokenParam{ToNodeID: local.IdP2, Amount: "1"}
+
+	// Transfer is denied until local.NDID sets token transfer policy
+	testApp.ExpectDeliver("TransferToken", transferParam, local.IdP1, local.IdP1PrivKey, code.TokenTransferIsNotAllowed)
+
+	testApp.MustDeliver("SetTokenTransferPolicy", app.SetTokenTransferPolicyParam{
+		Enabled: true,
+		OrganizationGroups: []app.OrganizationGroup{
+			{GroupID: "bank_a", NodeIDList: []string{local.IdP1, local.IdP2}},
+		},
+	}, local.NDID, local.NDIDPrivKey)
+	testApp.MustDeliver("TransferToken", transferParam, local.IdP1, local.IdP1PrivKey)
+	testApp.ExpectDeliver("TransferToken", app.TransferTokenParam{ToNodeID: local.AS1, Amount: "1"}, local.IdP1, local.IdP1PrivKey, code.TokenTransferIsNotAllowed)
+
+	testApp.MustDeliver("SetTokenTransferPolicy", app.SetTokenTransferPolicyParam{Enabled: false}, local.NDID, local.NDIDPrivKey)
+	testApp.MustDeliver("TransferToken", app.TransferTokenParam{ToNodeID: local.AS1, Amount: "1"}, local.IdP1, local.IdP1PrivKey)
+}
@@ -0,0 +1,56 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package ndid
+
+import (
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestVersionPruningSweep(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	for _, amount := range []int{10, 20, 30} {
+		testApp.MustDeliver("SetNodeToken", map[string]interface{}{
+			"node_id": local.IdP2,
+			"amount":  amount,
+		}, local.NDID, local.NDIDPrivKey)
+	}
+	if versions := testApp.KeyVersions("Token|" + local.IdP2); len(versions) < 4 {
+		t.Fatalf("FAIL: versions before policy is set\nExpected: at least 4 versions\nActual: %v", versions)
+	}
+
+	// Keys which are not written after policy is set are pruned by the sweep
+	testApp.MustDeliver("SetVersionPruningPolicy", app.SetVersionPruningPolicyParam{
+		KeepLastVersions: 1,
+	}, local.NDID, local.NDIDPrivKey)
+	testApp.EmptyBlocks(2)
+	if versions := testApp.KeyVersions("Token|" + local.IdP2); len(versions) != 1 {
+		t.Fatalf("FAIL: versions after sweep\nExpected: 1 version\nActual: %v", versions)
+	}
+	if versions := testApp.KeyVersions("Token|" + local.IdP1); len(versions) != 1 {
+		t.Fatalf("FAIL: versions of key written once\nExpected: 1 version\nActual: %v", versions)
+	}
+	t.Logf("PASS: SetVersionPruningPolicy sweep")
+}
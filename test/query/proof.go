@@ -0,0 +1,163 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	protoProof "github.com/ndidplatform/smart-contract/v4/protos/proof"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestQueryProof(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	appHash := testApp.AppHash()
+
+	res := testApp.Query("GetNodePublicKey", app.GetNodePublicKeyParam{NodeID: local.IdP1}, true)
+	if res.Height != testApp.Height {
+		t.Fatalf("FAIL: proof height\nExpected: %d\nActual: %d", testApp.Height, res.Height)
+	}
+	result, err := app.VerifyQueryProof(res.Value, res.Proof, appHash)
+	if err != nil {
+		t.Fatalf("FAIL: VerifyQueryProof: %s", err.Error())
+	}
+	if !strings.Contains(string(result.Value), "BEGIN PUBLIC KEY") {
+		t.Fatalf("FAIL: proven value does not contain public key: %s", string(result.Value))
+	}
+	if len(result.State) == 0 {
+		t.Fatalf("FAIL: no state items are proven")
+	}
+
+	// Tampered raw value must not verify
+	var tampered protoProof.ProvenQueryResult
+	proto.Merge(&tampered, result)
+	for _, item := range tampered.State {
+		if item.Exist {
+			item.Value = append(append([]byte{}, item.Value...), 0)
+			break
+		}
+	}
+	tamperedValue, _ := proto.Marshal(&tampered)
+	if _, err := app.VerifyQueryProof(tamperedValue, res.Proof, appHash); err == nil {
+		t.Fatalf("FAIL: proof of tampered value is verified")
+	}
+	t.Logf("PASS: inclusion proof")
+
+	// "not found" result is proven with absence proofs
+	res = testApp.Query("GetNodePublicKey", app.GetNodePublicKeyParam{NodeID: "unknown-node"}, true)
+	result, err = app.VerifyQueryProof(res.Value, res.Proof, appHash)
+	if err != nil {
+		t.Fatalf("FAIL: VerifyQueryProof of missing node: %s", err.Error())
+	}
+	for _, item := range result.State {
+		if item.Exist {
+			t.Fatalf("FAIL: key %q of missing node exists", string(item.Key))
+		}
+	}
+	// Absence proof cannot prove an existing key
+	for _, item := range result.State {
+		item.Exist = true
+	}
+	forgedValue, _ := proto.Marshal(result)
+	if _, err := app.VerifyQueryProof(forgedValue, res.Proof, appHash); err == nil {
+		t.Fatalf("FAIL: absence proof is verified as inclusion proof")
+	}
+	t.Logf("PASS: absence proof")
+
+	// Raw state key query
+	key := []byte("NonExistentKey")
+	res = testApp.App.Query(types.RequestQuery{Path: "/key", Data: key, Prove: true})
+	if res.Value != nil {
+		t.Fatalf("FAIL: value of missing key: %q", string(res.Value))
+	}
+	err = app.VerifyStateProof(app.NewProofRuntime(), res.Proof.Ops, appHash, key, nil)
+	if err != nil {
+		t.Fatalf("FAIL: absence proof of raw key: %s", err.Error())
+	}
+	if bytes.Equal(appHash, make([]byte, len(appHash))) {
+		t.Fatalf("FAIL: app hash is empty")
+	}
+	t.Logf("PASS: raw key absence proof")
+}
+
+func TestQueryProofAfterUpgrade(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+
+	// Make state DB look like state of a version without state tree
+	var deleteKeys [][]byte
+	itr := testApp.DB.Iterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		if bytes.HasPrefix(itr.Key(), []byte("StateTree")) {
+			deleteKeys = append(deleteKeys, append([]byte{}, itr.Key()...))
+		}
+	}
+	itr.Close()
+	for _, key := range deleteKeys {
+		testApp.DB.Delete(key)
+	}
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"height":   testApp.Height,
+		"app_hash": testApp.AppHash(),
+	})
+	testApp.DB.Set([]byte("stateKey"), metadata)
+
+	stateTreeHeight := testApp.Height + 2
+	os.Setenv("ABCI_STATE_TREE_HEIGHT", strconv.FormatInt(stateTreeHeight, 10))
+	defer os.Unsetenv("ABCI_STATE_TREE_HEIGHT")
+	upgradedApp := local.NewApp(t, testApp.DB)
+	upgradedApp.BlockTime = testApp.BlockTime
+
+	// App hash is still a hash chain before state tree height
+	upgradedApp.EmptyBlocks(1)
+	res := upgradedApp.Query("GetNodePublicKey", app.GetNodePublicKeyParam{NodeID: local.IdP1}, true)
+	if res.Log != app.ErrStateTreeHeightNotReached.Error() {
+		t.Fatalf("FAIL: proof before state tree height\nExpected: %s\nActual: %s", app.ErrStateTreeHeightNotReached.Error(), res.Log)
+	}
+	t.Logf("PASS: no proof before state tree height")
+
+	// Data written before upgrade is proven against app hash from state tree height
+	upgradedApp.EmptyBlocks(1)
+	if upgradedApp.Height != stateTreeHeight {
+		t.Fatalf("FAIL: height\nExpected: %d\nActual: %d", stateTreeHeight, upgradedApp.Height)
+	}
+	res = upgradedApp.Query("GetNodePublicKey", app.GetNodePublicKeyParam{NodeID: local.IdP1}, true)
+	result, err := app.VerifyQueryProof(res.Value, res.Proof, upgradedApp.AppHash())
+	if err != nil {
+		t.Fatalf("FAIL: VerifyQueryProof: %s", err.Error())
+	}
+	for _, item := range result.State {
+		if !item.Exist {
+			t.Fatalf("FAIL: key %q written before upgrade is not in state tree", string(item.Key))
+		}
+	}
+	t.Logf("PASS: proof of data written before upgrade")
+}
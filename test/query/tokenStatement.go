@@ -0,0 +1,82 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestTokenStatementProofAndHeight(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	setMqAddressesParam := app.SetMqAddressesParam{
+		Addresses: []app.MsqAddress{{IP: "127.0.0.1", Port: 8000}},
+	}
+	testApp.MustDeliver("SetMqAddresses", setMqAddressesParam, local.IdP1, local.IdP1PrivKey)
+	firstFeeHeight := testApp.Height
+	testApp.MustDeliver("SetMqAddresses", setMqAddressesParam, local.IdP1, local.IdP1PrivKey)
+	statementParam := app.GetTokenStatementParam{NodeID: local.IdP1}
+
+	// Entries after query height are not in statement even if to block
+	// height is after it
+	for _, toBlockHeight := range []int64{0, testApp.Height} {
+		statementParam.ToBlockHeight = toBlockHeight
+		res := testApp.QueryAt("GetTokenStatement", statementParam, firstFeeHeight, false)
+		var statement app.GetTokenStatementResult
+		err := json.Unmarshal(res.Value, &statement)
+		if err != nil {
+			t.Fatalf("FAIL: GetTokenStatement at height %d: %s (%s)", firstFeeHeight, err.Error(), res.Log)
+		}
+		if statement.TotalCount != 2 || statement.Entries[1].Balance != "99" {
+			t.Fatalf("FAIL: GetTokenStatement at height %d to block height %d: %+v", firstFeeHeight, toBlockHeight, statement)
+		}
+	}
+	t.Logf("PASS: token statement at query height")
+
+	// Every entry is proven
+	statementParam.ToBlockHeight = 0
+	res := testApp.Query("GetTokenStatement", statementParam, true)
+	result, err := app.VerifyQueryProof(res.Value, res.Proof, testApp.AppHash())
+	if err != nil {
+		t.Fatalf("FAIL: VerifyQueryProof: %s", err.Error())
+	}
+	var statement app.GetTokenStatementResult
+	err = json.Unmarshal(result.Value, &statement)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provenEntryCount := 0
+	for _, item := range result.State {
+		if item.Exist && bytes.HasPrefix(item.Key, []byte("TokenLedger|"+local.IdP1+"|")) {
+			provenEntryCount++
+		}
+	}
+	if statement.TotalCount != 3 || provenEntryCount != statement.TotalCount {
+		t.Fatalf("FAIL: proven token statement\nExpected: %d proven entries\nActual: %d of %+v", statement.TotalCount, provenEntryCount, statement)
+	}
+	t.Logf("PASS: token statement proof")
+}
@@ -0,0 +1,158 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ndidplatform/smart-contract/v4/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v4/test/local"
+)
+
+func TestUsageReport(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	setMqAddressesParam := app.SetMqAddressesParam{
+		Addresses: []app.MsqAddress{{IP: "127.0.0.1", Port: 8000}},
+	}
+	expectUsageReport := func(billingPeriod string, expectedCount int, expectedTotalPrice app.DecimalAmount) {
+		t.Helper()
+		var report app.GetUsageReportResult
+		testApp.QueryResult("GetUsageReport", app.GetUsageReportParam{NodeID: local.IdP1, BillingPeriod: billingPeriod}, &report)
+		if report.TotalCount != expectedCount || len(report.Entries) != expectedCount || report.TotalPrice != expectedTotalPrice {
+			t.Fatalf("FAIL: Usage report of %s\nExpected: %d entries, total price %s\nActual: %+v", billingPeriod, expectedCount, expectedTotalPrice, report)
+		}
+		for _, entry := range report.Entries {
+			if entry.Method != "SetMqAddresses" || entry.Price != "1" {
+				t.Fatalf("FAIL: Usage report entry of %s: %+v", billingPeriod, entry)
+			}
+		}
+	}
+
+	// Fees of Txs in the same block are separate entries
+	testApp.Block(
+		local.NewTx("SetMqAddresses", setMqAddressesParam, local.IdP1, local.IdP1PrivKey, 0),
+		local.NewTx("SetMqAddresses", setMqAddressesParam, local.IdP1, local.IdP1PrivKey, 0),
+	)
+	// Token changes which are not fees are not in usage report
+	testApp.MustDeliver("AddNodeToken", map[string]interface{}{
+		"node_id": local.IdP1,
+		"amount":  10,
+	}, local.NDID, local.NDIDPrivKey)
+	expectUsageReport("2017-07", 2, "2")
+
+	var statement app.GetTokenStatementResult
+	testApp.QueryResult("GetTokenStatement", app.GetTokenStatementParam{NodeID: local.IdP1}, &statement)
+	// Initial token, 2 fees and added token
+	if statement.TotalCount != 4 ||
+		statement.Entries[1].BlockHeight != statement.Entries[2].BlockHeight ||
+		statement.Entries[2].Balance != "98" ||
+		statement.Entries[3].Reason != "adjustment" {
+		t.Fatalf("FAIL: Token statement of %s: %+v", local.IdP1, statement)
+	}
+
+	// Next billing period
+	testApp.BlockTime = time.Date(2017, 8, 1, 0, 0, 0, 0, time.UTC)
+	testApp.MustDeliver("SetMqAddresses", setMqAddressesParam, local.IdP1, local.IdP1PrivKey)
+	expectUsageReport("2017-07", 2, "2")
+	expectUsageReport("2017-08", 1, "1")
+	expectUsageReport("2017-09", 0, "0")
+
+	var csv bytes.Buffer
+	count, err := app.ExportUsageReportCSV(testApp.DB, local.IdP1, "2017-07", &csv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 || strings.Count(csv.String(), "\n") != 3 {
+		t.Fatalf("FAIL: Usage report CSV\nExpected: 2 entries\nActual: %d entries\n%s", count, csv.String())
+	}
+}
+
+func TestUsageReportProofAndHeight(t *testing.T) {
+	testApp := local.NewInitializedApp(t)
+	setMqAddressesParam := app.SetMqAddressesParam{
+		Addresses: []app.MsqAddress{{IP: "127.0.0.1", Port: 8000}},
+	}
+	queryUsageReport := func(billingPeriod string, height int64) app.GetUsageReportResult {
+		t.Helper()
+		res := testApp.QueryAt("GetUsageReport", app.GetUsageReportParam{NodeID: local.IdP1, BillingPeriod: billingPeriod}, height, false)
+		var report app.GetUsageReportResult
+		err := json.Unmarshal(res.Value, &report)
+		if err != nil {
+			t.Fatalf("FAIL: GetUsageReport of %s at height %d: %s (%s)", billingPeriod, height, err.Error(), res.Log)
+		}
+		return report
+	}
+	testApp.MustDeliver("SetMqAddresses", setMqAddressesParam, local.IdP1, local.IdP1PrivKey)
+	firstFeeHeight := testApp.Height
+	testApp.MustDeliver("SetMqAddresses", setMqAddressesParam, local.IdP1, local.IdP1PrivKey)
+	testApp.BlockTime = time.Date(2017, 8, 1, 0, 0, 0, 0, time.UTC)
+	testApp.MustDeliver("SetMqAddresses", setMqAddressesParam, local.IdP1, local.IdP1PrivKey)
+
+	// Entries after query height are not in usage report
+	report := queryUsageReport("2017-07", firstFeeHeight)
+	if report.TotalCount != 1 || report.TotalPrice != "1" {
+		t.Fatalf("FAIL: Usage report of 2017-07 at height %d: %+v", firstFeeHeight, report)
+	}
+	report = queryUsageReport("2017-08", firstFeeHeight+1)
+	if report.TotalCount != 0 {
+		t.Fatalf("FAIL: Usage report of 2017-08 at height %d: %+v", firstFeeHeight+1, report)
+	}
+	report = queryUsageReport("2017-08", 0)
+	if report.TotalCount != 1 {
+		t.Fatalf("FAIL: Usage report of 2017-08: %+v", report)
+	}
+	t.Logf("PASS: usage report at query height")
+
+	// Billing periods and every token ledger entry read (initial token and
+	// 2 fees) are proven
+	res := testApp.Query("GetUsageReport", app.GetUsageReportParam{NodeID: local.IdP1, BillingPeriod: "2017-07"}, true)
+	result, err := app.VerifyQueryProof(res.Value, res.Proof, testApp.AppHash())
+	if err != nil {
+		t.Fatalf("FAIL: VerifyQueryProof: %s", err.Error())
+	}
+	err = json.Unmarshal(result.Value, &report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provenBillingPeriods := 0
+	provenEntryCount := 0
+	for _, item := range result.State {
+		if !item.Exist {
+			continue
+		}
+		if bytes.HasPrefix(item.Key, []byte("BillingPeriod|")) {
+			provenBillingPeriods++
+		}
+		if bytes.HasPrefix(item.Key, []byte("TokenLedger|"+local.IdP1+"|")) {
+			provenEntryCount++
+		}
+	}
+	if report.TotalCount != 2 || provenBillingPeriods != 2 || provenEntryCount != 3 {
+		t.Fatalf("FAIL: proven usage report\nExpected: 2 proven billing periods and 3 proven entries\nActual: %d billing periods and %d entries of %+v", provenBillingPeriods, provenEntryCount, report)
+	}
+	t.Logf("PASS: usage report proof")
+}